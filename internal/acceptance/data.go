@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -55,6 +56,9 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recording is the cassette used when recording or replaying this test, if enabled
+	recording *testRecording
 }
 
 // BuildTestData generates some test data for the given resource
@@ -85,9 +89,41 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		Secondary: os.Getenv("ARM_SUBSCRIPTION_ID_ALT"),
 	}
 
+	if recording := newTestRecording(t); recording != nil {
+		testData.applyRecording(recording)
+	}
+
 	return testData
 }
 
+// applyRecording ensures the random values and locations used in this test are consistent between recording
+// and replaying, since these form part of the recorded requests
+func (td *TestData) applyRecording(recording *testRecording) {
+	td.recording = recording
+
+	randomInteger := recording.variable("random_integer", func() string { return strconv.Itoa(td.RandomInteger) })
+	if v, err := strconv.Atoi(randomInteger); err == nil {
+		td.RandomInteger = v
+	}
+	td.RandomString = recording.variable("random_string", func() string { return td.RandomString })
+
+	td.Locations = Regions{
+		Primary:   recording.variable("location_primary", func() string { return td.Locations.Primary }),
+		Secondary: recording.variable("location_secondary", func() string { return td.Locations.Secondary }),
+		Ternary:   recording.variable("location_ternary", func() string { return td.Locations.Ternary }),
+	}
+
+	if recording.recorder.Replaying() {
+		if td.Subscriptions.Primary == "" {
+			td.Subscriptions.Primary = common.RecordingSubscriptionIDPlaceholder
+		}
+		if td.Subscriptions.Secondary == "" {
+			td.Subscriptions.Secondary = recordingSecondarySubscriptionIDPlaceholder
+		}
+	}
+	recording.recorder.Scrub(td.Subscriptions.Secondary, recordingSecondarySubscriptionIDPlaceholder)
+}
+
 // RandomIntOfLength is a random 8 to 18 digit integer which is unique to this test case
 func (td *TestData) RandomIntOfLength(length int) int {
 	// length should not be
@@ -123,6 +159,10 @@ func (td *TestData) RandomStringOfLength(length int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.recording != nil {
+		return td.recording.variable(fmt.Sprintf("random_string_of_length_%d", length), func() string { return randString(length) })
+	}
+
 	return randString(length)
 }
 
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// recordingSecondarySubscriptionIDPlaceholder replaces the secondary Subscription ID in recorded interactions
+const recordingSecondarySubscriptionIDPlaceholder = "00000000-0000-0000-0000-000000000002"
+
+// testRecording is the cassette used to record or replay the HTTP traffic sent during a single test
+type testRecording struct {
+	recorder *common.Recorder
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// newTestRecording returns a testRecording when `ARM_RECORDING_MODE` is set, using a cassette named after the test
+func newTestRecording(t *testing.T) *testRecording {
+	mode := testclient.RecordingMode()
+	if mode == "" {
+		return nil
+	}
+
+	path := filepath.Join(testclient.RecordingsDirectory(), fmt.Sprintf("%s.json", strings.ReplaceAll(t.Name(), "/", "_")))
+
+	// recording a test replaces any existing cassette, rather than appending to it
+	if mode == common.RecordingModeRecord {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			t.Fatalf("removing existing cassette %q: %+v", path, err)
+		}
+	}
+
+	recorder, err := common.NewRecorder(mode, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := recorder.Close(); err != nil {
+			t.Logf("closing recorder: %+v", err)
+		}
+	})
+
+	return &testRecording{
+		recorder: recorder,
+		counts:   make(map[string]int),
+	}
+}
+
+// variable returns the value for name from the cassette - since name can be requested multiple times within a
+// test, each request is stored separately
+func (r *testRecording) variable(name string, generate func() string) string {
+	r.mu.Lock()
+	key := fmt.Sprintf("%s_%d", name, r.counts[name])
+	r.counts[name]++
+	r.mu.Unlock()
+
+	return r.recorder.Variable(key, generate)
+}
+
+// testClient returns a client which uses the cassette for this test when recording or replaying
+func (td TestData) testClient() (*clients.Client, error) {
+	if td.recording != nil {
+		return testclient.BuildWithRecorder(td.recording.recorder)
+	}
+
+	return testclient.Build()
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 )
@@ -47,14 +46,14 @@ func (td TestData) DisappearsStep(data DisappearsStepData) resource.TestStep {
 		Config: config,
 		Check: resource.ComposeTestCheckFunc(
 			func(state *terraform.State) error {
-				client, err := testclient.Build()
+				client, err := td.testClient()
 				if err != nil {
 					return fmt.Errorf("building client: %+v", err)
 				}
 				return helpers.ExistsInAzure(client, data.TestResource, td.ResourceName)(state)
 			},
 			func(state *terraform.State) error {
-				client, err := testclient.Build()
+				client, err := td.testClient()
 				if err != nil {
 					return fmt.Errorf("building client: %+v", err)
 				}
@@ -83,7 +82,7 @@ func (td TestData) CheckWithClientForResource(check ClientCheckFunc, resourceNam
 				return fmt.Errorf("resource not found: %s", resourceName)
 			}
 
-			client, err := testclient.Build()
+			client, err := td.testClient()
 			if err != nil {
 				return fmt.Errorf("building client: %+v", err)
 			}
@@ -97,7 +96,7 @@ func (td TestData) CheckWithClientForResource(check ClientCheckFunc, resourceNam
 func (td TestData) CheckWithClientWithoutResource(check ClientCheckFunc) resource.TestCheckFunc {
 	return resource.ComposeTestCheckFunc(
 		func(state *terraform.State) error {
-			client, err := testclient.Build()
+			client, err := td.testClient()
 			if err != nil {
 				return fmt.Errorf("building client: %+v", err)
 			}
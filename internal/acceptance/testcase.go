@@ -8,11 +8,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 )
@@ -72,7 +72,7 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 	testCase := resource.TestCase{
 		PreCheck: func() { PreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
-			client, err := testclient.Build()
+			client, err := td.testClient()
 			if err != nil {
 				return fmt.Errorf("building client: %+v", err)
 			}
@@ -105,7 +105,7 @@ func (td TestData) ResourceTestIgnoreRecreate(t *testing.T, testResource types.T
 	testCase := resource.TestCase{
 		PreCheck: func() { PreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
-			client, err := testclient.Build()
+			client, err := td.testClient()
 			if err != nil {
 				return fmt.Errorf("building client: %+v", err)
 			}
@@ -140,7 +140,7 @@ func (td TestData) ResourceSequentialTest(t *testing.T, testResource types.TestR
 	testCase := resource.TestCase{
 		PreCheck: func() { PreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
-			client, err := testclient.Build()
+			client, err := td.testClient()
 			if err != nil {
 				return fmt.Errorf("building client: %+v", err)
 			}
@@ -168,18 +168,26 @@ func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testin
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm", "azurerm-alt")
+	testCase.ProtoV5ProviderFactories = td.providerFactories("azurerm", "azurerm-alt")
 
 	resource.ParallelTest(t, testCase)
 }
 
 func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProtoV5ProviderFactories = framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm")
+	testCase.ProtoV5ProviderFactories = td.providerFactories("azurerm")
 
 	resource.Test(t, testCase)
 }
 
+func (td TestData) providerFactories(providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
+	if td.recording != nil {
+		return framework.ProtoV5ProviderFactoriesWithRecorderInit(context.Background(), td.recording.recorder, providerNames...)
+	}
+
+	return framework.ProtoV5ProviderFactoriesInit(context.Background(), providerNames...)
+}
+
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
 	return map[string]resource.ExternalProvider{
 		"azuread": {
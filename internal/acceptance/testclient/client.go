@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -25,60 +28,101 @@ func Build() (*clients.Client, error) {
 	defer clientLock.Unlock()
 
 	if _client == nil {
-		var (
-			ctx = context.TODO()
-
-			env *environments.Environment
-			err error
-
-			metadataHost = os.Getenv("ARM_METADATA_HOSTNAME")
-		)
-
-		envName, exists := os.LookupEnv("ARM_ENVIRONMENT")
-		if !exists {
-			envName = "public"
-		}
-
-		if metadataHost != "" {
-			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
+		// this client is shared between tests, so uses a single cassette rather than the cassette for each test
+		var recorder *common.Recorder
+		if mode := RecordingMode(); mode != "" {
+			var err error
+			recorder, err = common.NewRecorder(mode, filepath.Join(RecordingsDirectory(), "testclient.json"))
+			if err != nil {
 				return nil, fmt.Errorf("building test client: %+v", err)
 			}
-		} else if env, err = environments.FromName(envName); err != nil {
-			return nil, fmt.Errorf("building test client: %+v", err)
 		}
 
-		authConfig := auth.Credentials{
-			Environment: *env,
-			ClientID:    os.Getenv("ARM_CLIENT_ID"),
-			TenantID:    os.Getenv("ARM_TENANT_ID"),
-
-			ClientCertificatePath:     os.Getenv("ARM_CLIENT_CERTIFICATE_PATH"),
-			ClientCertificatePassword: os.Getenv("ARM_CLIENT_CERTIFICATE_PASSWORD"),
-			ClientSecret:              os.Getenv("ARM_CLIENT_SECRET"),
-
-			EnableAuthenticatingUsingClientCertificate: true,
-			EnableAuthenticatingUsingClientSecret:      true,
-			EnableAuthenticatingUsingAzureCLI:          false,
-			EnableAuthenticatingUsingManagedIdentity:   false,
-			EnableAuthenticationUsingOIDC:              false,
-			EnableAuthenticationUsingGitHubOIDC:        false,
+		client, err := build(recorder)
+		if err != nil {
+			return nil, err
 		}
 
-		clientBuilder := clients.ClientBuilder{
-			AuthConfig:        &authConfig,
-			TerraformVersion:  os.Getenv("TERRAFORM_CORE_VERSION"),
-			Features:          features.Default(),
-			StorageUseAzureAD: false,
-			SubscriptionID:    os.Getenv("ARM_SUBSCRIPTION_ID"),
-		}
+		_client = client
+	}
 
-		client, err := clients.Build(ctx, clientBuilder)
-		if err != nil {
+	return _client, nil
+}
+
+// BuildWithRecorder returns a new client which records or replays all HTTP traffic using the specified Recorder
+func BuildWithRecorder(recorder *common.Recorder) (*clients.Client, error) {
+	return build(recorder)
+}
+
+// RecordingMode returns the mode specified in `ARM_RECORDING_MODE`, used to record or replay the HTTP traffic
+// sent during acceptance tests
+func RecordingMode() common.RecordingMode {
+	return common.RecordingMode(strings.ToLower(os.Getenv("ARM_RECORDING_MODE")))
+}
+
+// RecordingsDirectory returns the directory containing the cassettes used when recording or replaying, which
+// defaults to `testdata/recordings` within the package being tested
+func RecordingsDirectory() string {
+	if v := os.Getenv("ARM_TEST_RECORDINGS_DIR"); v != "" {
+		return v
+	}
+
+	return filepath.Join("testdata", "recordings")
+}
+
+func build(recorder *common.Recorder) (*clients.Client, error) {
+	var (
+		ctx = context.TODO()
+
+		env *environments.Environment
+		err error
+
+		metadataHost = os.Getenv("ARM_METADATA_HOSTNAME")
+	)
+
+	envName, exists := os.LookupEnv("ARM_ENVIRONMENT")
+	if !exists {
+		envName = "public"
+	}
+
+	if metadataHost != "" {
+		if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
 			return nil, fmt.Errorf("building test client: %+v", err)
 		}
+	} else if env, err = environments.FromName(envName); err != nil {
+		return nil, fmt.Errorf("building test client: %+v", err)
+	}
 
-		_client = client
+	authConfig := auth.Credentials{
+		Environment: *env,
+		ClientID:    os.Getenv("ARM_CLIENT_ID"),
+		TenantID:    os.Getenv("ARM_TENANT_ID"),
+
+		ClientCertificatePath:     os.Getenv("ARM_CLIENT_CERTIFICATE_PATH"),
+		ClientCertificatePassword: os.Getenv("ARM_CLIENT_CERTIFICATE_PASSWORD"),
+		ClientSecret:              os.Getenv("ARM_CLIENT_SECRET"),
+
+		EnableAuthenticatingUsingClientCertificate: true,
+		EnableAuthenticatingUsingClientSecret:      true,
+		EnableAuthenticatingUsingAzureCLI:          false,
+		EnableAuthenticatingUsingManagedIdentity:   false,
+		EnableAuthenticationUsingOIDC:              false,
+		EnableAuthenticationUsingGitHubOIDC:        false,
 	}
 
-	return _client, nil
+	clientBuilder := clients.ClientBuilder{
+		AuthConfig:        &authConfig,
+		TerraformVersion:  os.Getenv("TERRAFORM_CORE_VERSION"),
+		Features:          features.Default(),
+		StorageUseAzureAD: false,
+		SubscriptionID:    os.Getenv("ARM_SUBSCRIPTION_ID"),
+		Recorder:          recorder,
+	}
+
+	client, err := clients.Build(ctx, clientBuilder)
+	if err != nil {
+		return nil, fmt.Errorf("building test client: %+v", err)
+	}
+
+	return client, nil
 }
@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/claims"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
 
@@ -126,3 +128,41 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 
 	return &account, nil
 }
+
+// replayResourceManagerAccount builds the ResourceManagerAccount from the details stored in the cassette, since
+// no access token is available to inspect when replaying
+func replayResourceManagerAccount(builder ClientBuilder) *ResourceManagerAccount {
+	subscriptionId := builder.SubscriptionID
+	if subscriptionId == "" {
+		subscriptionId = common.RecordingSubscriptionIDPlaceholder
+	}
+
+	tenantId := builder.AuthConfig.TenantID
+	if tenantId == "" {
+		tenantId = common.RecordingTenantIDPlaceholder
+	}
+
+	recorder := builder.Recorder
+	return &ResourceManagerAccount{
+		Environment: builder.AuthConfig.Environment,
+
+		ClientId:       recorder.Variable("client_id", func() string { return builder.AuthConfig.ClientID }),
+		ObjectId:       recorder.Variable("object_id", func() string { return "" }),
+		SubscriptionId: subscriptionId,
+		TenantId:       tenantId,
+
+		AuthenticatedAsAServicePrincipal: recorder.Variable("authenticated_as_service_principal", func() string { return "true" }) == "true",
+		RegisteredResourceProviders:      builder.RegisteredResourceProviders,
+	}
+}
+
+// recordResourceManagerAccount stores the details of the authenticated principal in the cassette, and ensures
+// the Subscription and Tenant IDs are scrubbed from any recorded interactions
+func recordResourceManagerAccount(recorder *common.Recorder, account *ResourceManagerAccount) {
+	recorder.Scrub(account.SubscriptionId, common.RecordingSubscriptionIDPlaceholder)
+	recorder.Scrub(account.TenantId, common.RecordingTenantIDPlaceholder)
+
+	recorder.Variable("client_id", func() string { return account.ClientId })
+	recorder.Variable("object_id", func() string { return account.ObjectId })
+	recorder.Variable("authenticated_as_service_principal", func() string { return strconv.FormatBool(account.AuthenticatedAsAServicePrincipal) })
+}
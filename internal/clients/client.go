@@ -160,6 +160,9 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// Authorizers are the authorizers built from the Provider's credentials, used to issue access tokens
+	Authorizers *common.Authorizers
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -299,6 +302,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 		return fmt.Errorf("building auto-clients: %+v", err)
 	}
 
+	client.Authorizers = o.Authorizers
 	client.Features = o.Features
 	client.StopContext = ctx
 
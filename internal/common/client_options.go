@@ -9,7 +9,6 @@ import (
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
@@ -50,6 +49,12 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// Recorder, when set, records or replays all HTTP traffic sent by the configured clients
+	Recorder *Recorder
+
+	// Retry, when set, configures how requests to Resource Manager are retried and rate limited
+	Retry *RetryOptions
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -74,7 +79,22 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	var retry *throttling
+	if o.Retry != nil {
+		retry = newThrottling(*o.Retry, o.ResourceManagerEndpoint, o.SubscriptionId)
+		c.AppendRequestMiddleware(retry.requestMiddleware())
+	}
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
+	if o.Recorder != nil {
+		c.AppendRequestMiddleware(o.Recorder.requestMiddleware())
+		c.AppendResponseMiddleware(o.Recorder.responseMiddleware())
+	}
+	if retry != nil {
+		// this runs after the Recorder, which restores the original request URL when replaying
+		c.AppendResponseMiddleware(retry.responseMiddleware())
+	}
+	c.AppendResponseMiddleware(operationProgressMiddleware())
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
 }
 
@@ -83,7 +103,14 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = buildSender("AzureRM")
+	if o.Retry != nil {
+		c.RetryAttempts = o.Retry.maxAttempts()
+		c.Sender = newThrottling(*o.Retry, o.ResourceManagerEndpoint, o.SubscriptionId).autorestSender(c.Sender)
+	}
+	if o.Recorder != nil {
+		c.Sender = o.Recorder.autorestSender(c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
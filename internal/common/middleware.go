@@ -6,8 +6,8 @@ package common
 import (
 	"log"
 	"net/http"
-	"net/http/httputil"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
@@ -23,24 +23,12 @@ func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 
 func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// strip the authorization header prior to printing
-		authHeaderName := "Authorization"
-		auth := request.Header.Get(authHeaderName)
-		if auth != "" {
-			request.Header.Del(authHeaderName)
-		}
-
-		// dump request to wire format
-		if dump, err := httputil.DumpRequestOut(request, true); err == nil {
+		// dump request to wire format, with any secrets redacted
+		if dump, err := dumpRequest(request); err == nil {
 			log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
 		} else {
 			// fallback to basic message
-			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, request.URL)
-		}
-
-		// add the auth header back
-		if auth != "" {
-			request.Header.Add(authHeaderName, auth)
+			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, redactedURL(request))
 		}
 
 		return request, nil
@@ -49,13 +37,55 @@ func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 
 func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
 	return func(request *http.Request, response *http.Response) (*http.Response, error) {
-		// dump response to wire format
-		if dump, err2 := httputil.DumpResponse(response, true); err2 == nil {
-			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, request.URL, dump)
+		// dump response to wire format, with any secrets redacted
+		if dump, err2 := dumpResponse(response); err2 == nil {
+			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, redactedURL(request), dump)
 		} else {
 			// fallback to basic message
-			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, request.URL)
+			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, redactedURL(request))
 		}
 		return response, nil
 	}
 }
+
+// buildSender returns the autorest.Sender used by go-autorest clients, which logs requests and responses in the
+// same way as the middlewares used by go-azure-sdk clients
+func buildSender(providerName string) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}, withRequestLogging(providerName))
+}
+
+func withRequestLogging(providerName string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if _, err := requestLoggerMiddleware(providerName)(r); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				if _, err2 := responseLoggerMiddleware(providerName)(r, resp); err2 != nil {
+					return resp, err2
+				}
+			} else if err != nil {
+				log.Printf("[DEBUG] %s Response Error: %s for %s\n", providerName, err, redactedURL(r))
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", redactedURL(r))
+			}
+			return resp, err
+		})
+	}
+}
+
+func redactedURL(request *http.Request) string {
+	if request == nil || request.URL == nil {
+		return ""
+	}
+
+	u := *request.URL
+	u.RawQuery = redactQueryString(u.RawQuery)
+	return u.String()
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// OperationProgress is the progress of a long-running operation, as returned when polling it
+type OperationProgress struct {
+	// Status is the `status` of the operation, or the `provisioningState` of the resource when polling the resource
+	Status string
+
+	// PercentComplete is the `percentComplete` of the operation, when returned by the API
+	PercentComplete *float64
+}
+
+// OperationProgressFunc is called with the progress of a long-running operation each time it's polled
+type OperationProgressFunc func(progress OperationProgress)
+
+type operationProgressKey struct{}
+
+// WithOperationProgress returns a context which reports the progress of any long-running operation polled using it
+// to progressFunc. This only applies to go-azure-sdk clients.
+func WithOperationProgress(ctx context.Context, progressFunc OperationProgressFunc) context.Context {
+	return context.WithValue(ctx, operationProgressKey{}, progressFunc)
+}
+
+func operationProgressMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		progressFunc, ok := request.Context().Value(operationProgressKey{}).(OperationProgressFunc)
+		if !ok || response == nil || response.Body == nil {
+			return response, nil
+		}
+
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading response body: %+v", err)
+		}
+		response.Body = io.NopCloser(bytes.NewReader(body))
+
+		if progress, ok := parseOperationProgress(body); ok {
+			progressFunc(progress)
+		}
+
+		return response, nil
+	}
+}
+
+// parseOperationProgress returns the progress from either an operation status or the resource itself, since
+// long-running operations are polled using either the `Azure-AsyncOperation`/`Location` URI or the resource
+func parseOperationProgress(body []byte) (OperationProgress, bool) {
+	var payload struct {
+		Status          string   `json:"status"`
+		PercentComplete *float64 `json:"percentComplete"`
+		Properties      *struct {
+			ProvisioningState string `json:"provisioningState"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return OperationProgress{}, false
+	}
+
+	progress := OperationProgress{
+		Status:          payload.Status,
+		PercentComplete: payload.PercentComplete,
+	}
+	if progress.Status == "" && payload.Properties != nil {
+		progress.Status = payload.Properties.ProvisioningState
+	}
+
+	return progress, progress.Status != "" || progress.PercentComplete != nil
+}
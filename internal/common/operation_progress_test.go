@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestParseOperationProgress(t *testing.T) {
+	testData := []struct {
+		body            string
+		status          string
+		percentComplete float64
+		found           bool
+	}{
+		{
+			body:            `{"status": "InProgress", "percentComplete": 45.5}`,
+			status:          "InProgress",
+			percentComplete: 45.5,
+			found:           true,
+		},
+		{
+			body:   `{"status": "Succeeded"}`,
+			status: "Succeeded",
+			found:  true,
+		},
+		{
+			body:   `{"name": "example", "properties": {"provisioningState": "Updating"}}`,
+			status: "Updating",
+			found:  true,
+		},
+		{
+			body:  `{"name": "example", "properties": {}}`,
+			found: false,
+		},
+		{
+			body:  ``,
+			found: false,
+		},
+		{
+			body:  `not json`,
+			found: false,
+		},
+	}
+
+	for _, v := range testData {
+		actual, found := parseOperationProgress([]byte(v.body))
+		if found != v.found {
+			t.Fatalf("expected found to be %t for %q but got %t", v.found, v.body, found)
+		}
+		if actual.Status != v.status {
+			t.Fatalf("expected status %q for %q but got %q", v.status, v.body, actual.Status)
+		}
+		if v.percentComplete != 0 && (actual.PercentComplete == nil || *actual.PercentComplete != v.percentComplete) {
+			t.Fatalf("expected percent complete %f for %q but got %v", v.percentComplete, v.body, actual.PercentComplete)
+		}
+	}
+}
+
+func TestOperationProgressMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status": "InProgress", "percentComplete": 45}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test", "2020-01-01")
+	ClientOptions{}.Configure(c, nil)
+
+	reported := make([]OperationProgress, 0)
+	ctx := WithOperationProgress(context.Background(), func(progress OperationProgress) {
+		reported = append(reported, progress)
+	})
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/operations/example",
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := c.Execute(ctx, req)
+	if err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+
+	if len(reported) != 1 || reported[0].Status != "InProgress" || reported[0].PercentComplete == nil || *reported[0].PercentComplete != 45 {
+		t.Fatalf("expected the operation progress to be reported but got %+v", reported)
+	}
+
+	// the response body must still be readable by the poller
+	var model struct {
+		Status string `json:"status"`
+	}
+	if err := resp.Unmarshal(&model); err != nil {
+		t.Fatalf("unmarshalling response: %+v", err)
+	}
+	if model.Status != "InProgress" {
+		t.Fatalf("expected the response body to be unchanged but got status %q", model.Status)
+	}
+}
@@ -0,0 +1,656 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"golang.org/x/oauth2"
+)
+
+// RecordingMode determines whether HTTP traffic is captured to, or served from, a cassette file
+type RecordingMode string
+
+const (
+	RecordingModeRecord RecordingMode = "record"
+	RecordingModeReplay RecordingMode = "replay"
+)
+
+const (
+	// RecordingSubscriptionIDPlaceholder replaces the Subscription ID in recorded interactions
+	RecordingSubscriptionIDPlaceholder = "00000000-0000-0000-0000-000000000000"
+
+	// RecordingTenantIDPlaceholder replaces the Tenant ID in recorded interactions
+	RecordingTenantIDPlaceholder = "00000000-0000-0000-0000-000000000001"
+
+	// recordingRedactedValue replaces the value of any sensitive JSON property in recorded interactions
+	recordingRedactedValue = "REDACTED"
+
+	// headerRecordingOriginalURL is used to pass the original URL of a request through to the local replay server
+	headerRecordingOriginalURL = "X-Ms-Recording-Original-Url"
+)
+
+// recordingExcludedHeaders are response headers which are not written to the cassette, since they're either
+// sensitive or specific to a single request and not useful when replaying
+var recordingExcludedHeaders = map[string]struct{}{
+	"Authorization":               {},
+	"Date":                        {},
+	"Set-Cookie":                  {},
+	"Strict-Transport-Security":   {},
+	"X-Cache":                     {},
+	"X-Content-Type-Options":      {},
+	"X-Ms-Correlation-Request-Id": {},
+	"X-Ms-Request-Id":             {},
+	"X-Ms-Routing-Request-Id":     {},
+	"X-Msedge-Ref":                {},
+}
+
+type cassette struct {
+	Variables    map[string]string      `json:"variables,omitempty"`
+	Interactions []*recordedInteraction `json:"interactions"`
+}
+
+type recordedInteraction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+
+	// replayed tracks whether this interaction has already been served, so that subsequent identical
+	// requests (e.g. when polling) are served the next recorded response
+	replayed bool
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+func (r recordedRequest) matches(other recordedRequest) bool {
+	return strings.EqualFold(r.Method, other.Method) && strings.EqualFold(r.URL, other.URL) && r.Body == other.Body
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type recordingSubstitution struct {
+	value       *regexp.Regexp
+	original    string
+	placeholder string
+}
+
+// Recorder captures the HTTP traffic sent by both the go-azure-sdk and go-autorest clients to a cassette file,
+// or serves previously captured traffic back from that file so that the Provider can run without network
+// access or credentials.
+//
+// Interactions are keyed on the HTTP Method, the URL and the normalised request body - and any Subscription ID,
+// Tenant ID or sensitive values are scrubbed before being written to the cassette.
+type Recorder struct {
+	mode RecordingMode
+	path string
+
+	mu            sync.Mutex
+	cassette      cassette
+	substitutions []recordingSubstitution
+
+	serverOnce sync.Once
+	server     *http.Server
+	serverURL  *url.URL
+	serverErr  error
+}
+
+// NewRecorder returns a Recorder using the cassette at path. When recording, any existing interactions within
+// the cassette are retained and new interactions are appended - when replaying the cassette must exist.
+func NewRecorder(mode RecordingMode, path string) (*Recorder, error) {
+	if mode != RecordingModeRecord && mode != RecordingModeReplay {
+		return nil, fmt.Errorf("unsupported recording mode %q, expected %q or %q", mode, RecordingModeRecord, RecordingModeReplay)
+	}
+	if path == "" {
+		return nil, errors.New("a path to the cassette must be specified when recording or replaying")
+	}
+
+	r := &Recorder{
+		mode: mode,
+		path: path,
+		cassette: cassette{
+			Variables:    make(map[string]string),
+			Interactions: make([]*recordedInteraction, 0),
+		},
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) || mode == RecordingModeReplay {
+			return nil, fmt.Errorf("reading cassette %q: %+v", path, err)
+		}
+		return r, nil
+	}
+
+	if err := json.Unmarshal(contents, &r.cassette); err != nil {
+		return nil, fmt.Errorf("parsing cassette %q: %+v", path, err)
+	}
+	if r.cassette.Variables == nil {
+		r.cassette.Variables = make(map[string]string)
+	}
+
+	return r, nil
+}
+
+// NewRecorderFromEnvironment returns a Recorder when `ARM_RECORDING_MODE` is set, using the cassette specified
+// in `ARM_RECORDING_PATH`. When `ARM_RECORDING_MODE` isn't set no Recorder is returned.
+func NewRecorderFromEnvironment() (*Recorder, error) {
+	mode := os.Getenv("ARM_RECORDING_MODE")
+	if mode == "" {
+		return nil, nil
+	}
+
+	return NewRecorder(RecordingMode(strings.ToLower(mode)), os.Getenv("ARM_RECORDING_PATH"))
+}
+
+// Replaying returns whether this Recorder is serving responses from the cassette, rather than the network
+func (r *Recorder) Replaying() bool {
+	return r.mode == RecordingModeReplay
+}
+
+// Scrub configures the Recorder to replace all (case-insensitive) occurrences of value with placeholder when
+// recording - and to replace placeholder with value when replaying.
+func (r *Recorder) Scrub(value, placeholder string) {
+	if value == "" || placeholder == "" || value == placeholder {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.substitutions {
+		if strings.EqualFold(v.original, value) {
+			return
+		}
+	}
+
+	r.substitutions = append(r.substitutions, recordingSubstitution{
+		value:       regexp.MustCompile("(?i)" + regexp.QuoteMeta(value)),
+		original:    value,
+		placeholder: placeholder,
+	})
+}
+
+// Variable returns the value stored in the cassette for name. When recording and no value exists, the value
+// is obtained from generate and stored in the cassette so that it's available when replaying.
+func (r *Recorder) Variable(name string, generate func() string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.cassette.Variables[name]; ok {
+		return v
+	}
+
+	v := generate()
+	if r.mode == RecordingModeReplay {
+		log.Printf("[WARN] variable %q was not found in cassette %q, using a generated value", name, r.path)
+		return v
+	}
+
+	r.cassette.Variables[name] = v
+	if err := r.save(); err != nil {
+		log.Printf("[WARN] %+v", err)
+	}
+
+	return v
+}
+
+// Authorizer returns an auth.Authorizer which issues a static token, for use when replaying since no
+// credentials are available.
+func (r *Recorder) Authorizer() auth.Authorizer {
+	return replayAuthorizer{}
+}
+
+// Close stops the local replay server, if one has been started
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	server := r.server
+	r.server = nil
+	r.mu.Unlock()
+
+	if server == nil {
+		return nil
+	}
+
+	return server.Close()
+}
+
+func (r *Recorder) requestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := bufferRequestBody(request); err != nil {
+			return nil, err
+		}
+
+		if r.mode == RecordingModeRecord {
+			return request, nil
+		}
+
+		// the go-azure-sdk doesn't expose the underlying transport, so redirect the request to the local replay server
+		serverURL, err := r.startServer()
+		if err != nil {
+			return nil, err
+		}
+
+		replayRequest := request.Clone(request.Context())
+		replayRequest.URL.Scheme = serverURL.Scheme
+		replayRequest.URL.Host = serverURL.Host
+		replayRequest.Host = ""
+		replayRequest.Header.Set(headerRecordingOriginalURL, request.URL.String())
+
+		return replayRequest, nil
+	}
+}
+
+func (r *Recorder) responseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if r.mode == RecordingModeReplay {
+			// restore the original URL, since pollers build subsequent requests from it
+			if originalURL := request.Header.Get(headerRecordingOriginalURL); originalURL != "" {
+				u, err := url.Parse(originalURL)
+				if err != nil {
+					return nil, fmt.Errorf("parsing original URL %q: %+v", originalURL, err)
+				}
+
+				originalRequest := request.Clone(request.Context())
+				originalRequest.URL = u
+				originalRequest.Host = u.Host
+				originalRequest.Header.Del(headerRecordingOriginalURL)
+				response.Request = originalRequest
+			}
+
+			return response, nil
+		}
+
+		if err := r.record(request, response); err != nil {
+			return nil, err
+		}
+
+		return response, nil
+	}
+}
+
+// autorestSender wraps the go-autorest Sender so that traffic is recorded, or replayed without hitting the network
+func (r *Recorder) autorestSender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if err := bufferRequestBody(request); err != nil {
+			return nil, err
+		}
+
+		if r.mode == RecordingModeReplay {
+			body, err := readRequestBody(request)
+			if err != nil {
+				return nil, err
+			}
+
+			interaction, err := r.match(request.Method, request.URL, body)
+			if err != nil {
+				return nil, err
+			}
+
+			return r.replayResponse(interaction, request), nil
+		}
+
+		response, err := sender.Do(request)
+		if err != nil {
+			return response, err
+		}
+
+		if err := r.record(request, response); err != nil {
+			return nil, err
+		}
+
+		return response, nil
+	})
+}
+
+func (r *Recorder) record(request *http.Request, response *http.Response) error {
+	requestBody, err := readRequestBody(request)
+	if err != nil {
+		return err
+	}
+
+	var responseBody []byte
+	if response.Body != nil {
+		responseBody, err = io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("reading response body: %+v", err)
+		}
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(responseBody))
+	}
+
+	// the substitutions can be added to by another client whilst recording, so must be read under the lock
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	headers := http.Header{}
+	for k, values := range response.Header {
+		if _, ok := recordingExcludedHeaders[http.CanonicalHeaderKey(k)]; ok {
+			continue
+		}
+		for _, v := range values {
+			headers.Add(k, r.scrub(v))
+		}
+	}
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &recordedInteraction{
+		Request: r.normaliseRequest(request.Method, request.URL, requestBody),
+		Response: recordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    headers,
+			Body:       r.normaliseBody(responseBody),
+		},
+	})
+
+	return r.save()
+}
+
+// match returns the first interaction matching the request which hasn't yet been replayed, falling back to the
+// last matching interaction when all have been replayed
+func (r *Recorder) match(method string, u *url.URL, body []byte) (*recordedInteraction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request := r.normaliseRequest(method, u, body)
+
+	var last *recordedInteraction
+	for _, interaction := range r.cassette.Interactions {
+		if !interaction.Request.matches(request) {
+			continue
+		}
+
+		if !interaction.replayed {
+			interaction.replayed = true
+			return interaction, nil
+		}
+		last = interaction
+	}
+
+	if last != nil {
+		return last, nil
+	}
+
+	return nil, fmt.Errorf("no interaction was recorded in %q for %s %s", r.path, request.Method, request.URL)
+}
+
+func (r *Recorder) replayResponse(interaction *recordedInteraction, request *http.Request) *http.Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	headers := http.Header{}
+	for k, values := range interaction.Response.Headers {
+		for _, v := range values {
+			headers.Add(k, r.unscrub(v))
+		}
+	}
+
+	// there's no need to wait when replaying
+	if headers.Get("Retry-After") != "" {
+		headers.Set("Retry-After", "0")
+	}
+
+	body := r.unscrub(interaction.Response.Body)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
+
+func (r *Recorder) startServer() (*url.URL, error) {
+	r.serverOnce.Do(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			r.serverErr = fmt.Errorf("starting replay server: %+v", err)
+			return
+		}
+
+		server := &http.Server{
+			Handler: http.HandlerFunc(r.serveHTTP),
+		}
+		r.serverURL = &url.URL{
+			Scheme: "http",
+			Host:   listener.Addr().String(),
+		}
+
+		// the server is read by Close, which can be called whilst requests are in flight
+		r.mu.Lock()
+		r.server = server
+		r.mu.Unlock()
+
+		go func() {
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("[ERROR] replay server for %q stopped: %+v", r.path, err)
+			}
+		}()
+	})
+
+	return r.serverURL, r.serverErr
+}
+
+func (r *Recorder) serveHTTP(w http.ResponseWriter, request *http.Request) {
+	originalURL, err := url.Parse(request.Header.Get(headerRecordingOriginalURL))
+	if err != nil {
+		writeReplayError(w, fmt.Errorf("parsing original URL: %+v", err))
+		return
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		writeReplayError(w, fmt.Errorf("reading request body: %+v", err))
+		return
+	}
+
+	interaction, err := r.match(request.Method, originalURL, body)
+	if err != nil {
+		writeReplayError(w, err)
+		return
+	}
+
+	response := r.replayResponse(interaction, request)
+	for k, values := range response.Header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	io.Copy(w, response.Body) // nolint: errcheck
+}
+
+// writeReplayError returns a 501 since this is not retried by the go-azure-sdk
+func writeReplayError(w http.ResponseWriter, err error) {
+	log.Printf("[ERROR] replaying request: %+v", err)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]string{
+			"code":    "RecordingNotFound",
+			"message": err.Error(),
+		},
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotImplemented)
+	w.Write(body) // nolint: errcheck
+}
+
+func (r *Recorder) normaliseRequest(method string, u *url.URL, body []byte) recordedRequest {
+	normalisedURL := *u
+	normalisedURL.Scheme = strings.ToLower(u.Scheme)
+	normalisedURL.Host = strings.ToLower(u.Host)
+	normalisedURL.RawQuery = u.Query().Encode()
+
+	return recordedRequest{
+		Method: strings.ToUpper(method),
+		URL:    r.scrub(normalisedURL.String()),
+		Body:   r.normaliseBody(body),
+	}
+}
+
+// normaliseBody scrubs the body, and when the body is JSON also redacts sensitive values and sorts the keys
+// so that semantically identical payloads are matched
+func (r *Recorder) normaliseBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return r.scrub(string(body))
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactSensitiveProperties(v)); err != nil {
+		return r.scrub(string(body))
+	}
+
+	return r.scrub(strings.TrimSpace(buf.String()))
+}
+
+// scrub replaces each substituted value with its placeholder. The caller must hold the lock.
+func (r *Recorder) scrub(input string) string {
+	for _, v := range r.substitutions {
+		input = v.value.ReplaceAllLiteralString(input, v.placeholder)
+	}
+	return input
+}
+
+// unscrub replaces each placeholder with the substituted value. The caller must hold the lock.
+func (r *Recorder) unscrub(input string) string {
+	for _, v := range r.substitutions {
+		input = strings.ReplaceAll(input, v.placeholder, v.original)
+	}
+	return input
+}
+
+// save writes the cassette to disk - which happens after each interaction since there's no guarantee that the
+// Provider process is shut down gracefully. The caller must hold the lock.
+func (r *Recorder) save() error {
+	if r.mode != RecordingModeRecord {
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing cassette %q: %+v", r.path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for cassette %q: %+v", r.path, err)
+	}
+
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, contents, 0o644); err != nil { // nolint: gosec
+		return fmt.Errorf("writing cassette %q: %+v", r.path, err)
+	}
+
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("writing cassette %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+func redactSensitiveProperties(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			// the same properties are redacted as when logging, so that secrets aren't written to the cassette
+			if _, isString := value.(string); isString && isSensitiveProperty(key) {
+				v[key] = recordingRedactedValue
+				continue
+			}
+			v[key] = redactSensitiveProperties(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactSensitiveProperties(value)
+		}
+	}
+
+	return input
+}
+
+// bufferRequestBody reads the request body into memory so that it can be read again via GetBody
+func bufferRequestBody(request *http.Request) error {
+	if request.Body == nil || request.Body == http.NoBody || request.GetBody != nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body: %+v", err)
+	}
+	request.Body.Close()
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return nil
+}
+
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.GetBody == nil {
+		return nil, nil
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+type replayAuthorizer struct{}
+
+var _ auth.Authorizer = replayAuthorizer{}
+
+func (replayAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "replay",
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (replayAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
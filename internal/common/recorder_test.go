@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	testRecorderSubscriptionId = "11111111-2222-3333-4444-555555555555"
+	testRecorderResourceURL    = "https://management.azure.com/subscriptions/11111111-2222-3333-4444-555555555555/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example?api-version=2023-05-01"
+)
+
+func TestRecorderRecordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewRecorder(RecordingModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	recorder.Scrub(testRecorderSubscriptionId, RecordingSubscriptionIDPlaceholder)
+
+	responses := []string{
+		`{"id": "/subscriptions/11111111-2222-3333-4444-555555555555/resourceGroups/example", "properties": {"provisioningState": "Creating"}}`,
+		`{"id": "/subscriptions/11111111-2222-3333-4444-555555555555/resourceGroups/example", "properties": {"provisioningState": "Succeeded", "primaryKey": "secret"}}`,
+	}
+	attempt := 0
+	sender := recorder.autorestSender(autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		body := responses[attempt]
+		attempt++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type":    []string{"application/json"},
+				"X-Ms-Request-Id": []string{"abc123"},
+			},
+			Body:    io.NopCloser(strings.NewReader(body)),
+			Request: request,
+		}, nil
+	}))
+
+	for range responses {
+		request, _ := http.NewRequest(http.MethodPut, testRecorderResourceURL, bytes.NewBufferString(`{"location": "westeurope", "tags": {"a": "b"}}`))
+		if _, err := sender.Do(request); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	replayer, err := NewRecorder(RecordingModeReplay, path)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	replaySubscriptionId := "99999999-8888-7777-6666-555555555555"
+	replayer.Scrub(replaySubscriptionId, RecordingSubscriptionIDPlaceholder)
+
+	replaySender := replayer.autorestSender(autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		t.Fatalf("request should not be sent when replaying")
+		return nil, nil
+	}))
+
+	replayURL := strings.ReplaceAll(testRecorderResourceURL, testRecorderSubscriptionId, replaySubscriptionId)
+	expected := []string{
+		`{"id":"/subscriptions/99999999-8888-7777-6666-555555555555/resourceGroups/example","properties":{"provisioningState":"Creating"}}`,
+		`{"id":"/subscriptions/99999999-8888-7777-6666-555555555555/resourceGroups/example","properties":{"primaryKey":"REDACTED","provisioningState":"Succeeded"}}`,
+		// once all matching interactions are replayed the last is repeated
+		`{"id":"/subscriptions/99999999-8888-7777-6666-555555555555/resourceGroups/example","properties":{"primaryKey":"REDACTED","provisioningState":"Succeeded"}}`,
+	}
+	for i, v := range expected {
+		// the keys are intentionally in a different order to the recorded request
+		request, _ := http.NewRequest(http.MethodPut, replayURL, bytes.NewBufferString(`{"tags": {"a": "b"}, "location": "westeurope"}`))
+		response, err := replaySender.Do(request)
+		if err != nil {
+			t.Fatalf("replaying request %d: %+v", i, err)
+		}
+
+		body, _ := io.ReadAll(response.Body)
+		if string(body) != v {
+			t.Fatalf("expected response %d to be %s but got %s", i, v, body)
+		}
+		if response.Header.Get("X-Ms-Request-Id") != "" {
+			t.Fatalf("expected the `X-Ms-Request-Id` header to be excluded from the recording")
+		}
+	}
+
+	request, _ := http.NewRequest(http.MethodDelete, replayURL, nil)
+	if _, err := replaySender.Do(request); err == nil {
+		t.Fatalf("expected an error for a request which wasn't recorded")
+	}
+}
+
+func TestRecorderReplayMiddleware(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	recorder, err := NewRecorder(RecordingModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, testRecorderResourceURL, nil)
+	response := &http.Response{
+		StatusCode: http.StatusAccepted,
+		Header: http.Header{
+			"Location":    []string{"https://management.azure.com/operations/1"},
+			"Retry-After": []string{"30"},
+		},
+		Body: io.NopCloser(strings.NewReader(`{}`)),
+	}
+	if _, err := recorder.responseMiddleware()(request, response); err != nil {
+		t.Fatalf("recording response: %+v", err)
+	}
+
+	replayer, err := NewRecorder(RecordingModeReplay, path)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	defer replayer.Close()
+
+	request, _ = http.NewRequest(http.MethodGet, testRecorderResourceURL, nil)
+	replayRequest, err := replayer.requestMiddleware()(request)
+	if err != nil {
+		t.Fatalf("running request middleware: %+v", err)
+	}
+	if replayRequest.URL.Host == request.URL.Host {
+		t.Fatalf("expected the request to be redirected to the replay server")
+	}
+
+	replayResponse, err := http.DefaultClient.Do(replayRequest)
+	if err != nil {
+		t.Fatalf("sending request to replay server: %+v", err)
+	}
+	defer replayResponse.Body.Close()
+
+	replayResponse, err = replayer.responseMiddleware()(replayRequest, replayResponse)
+	if err != nil {
+		t.Fatalf("running response middleware: %+v", err)
+	}
+
+	if replayResponse.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected status %d but got %d", http.StatusAccepted, replayResponse.StatusCode)
+	}
+	if v := replayResponse.Header.Get("Location"); v != "https://management.azure.com/operations/1" {
+		t.Fatalf("expected the `Location` header to be replayed but got %q", v)
+	}
+	if v := replayResponse.Header.Get("Retry-After"); v != "0" {
+		t.Fatalf("expected the `Retry-After` header to be `0` but got %q", v)
+	}
+	if v := replayResponse.Request.URL.String(); v != testRecorderResourceURL {
+		t.Fatalf("expected the original URL %q to be restored but got %q", testRecorderResourceURL, v)
+	}
+}
+
+func TestRecorderRecordConcurrently(t *testing.T) {
+	recorder, err := NewRecorder(RecordingModeRecord, filepath.Join(t.TempDir(), "cassette.json"))
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	sender := recorder.autorestSender(autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Location": []string{testRecorderResourceURL},
+			},
+			Body:    io.NopCloser(strings.NewReader(`{}`)),
+			Request: request,
+		}, nil
+	}))
+
+	// each client registers its own substitutions whilst other clients may already be sending requests
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			recorder.Scrub(fmt.Sprintf("value-%d", i), fmt.Sprintf("placeholder-%d", i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			request, _ := http.NewRequest(http.MethodGet, testRecorderResourceURL, nil)
+			if _, err := sender.Do(request); err != nil {
+				t.Errorf("sending request: %+v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(recorder.cassette.Interactions) != 10 {
+		t.Fatalf("expected 10 interactions to be recorded but got %d", len(recorder.cassette.Interactions))
+	}
+}
+
+func TestRecorderCloseWhilstReplaying(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if err := os.WriteFile(path, []byte(`{"interactions":[]}`), 0o600); err != nil {
+		t.Fatalf("writing cassette: %+v", err)
+	}
+
+	replayer, err := NewRecorder(RecordingModeReplay, path)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+
+	// the replay server is started by the first request, which can happen whilst the Provider is being stopped
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		request, _ := http.NewRequest(http.MethodGet, testRecorderResourceURL, nil)
+		if _, err := replayer.requestMiddleware()(request); err != nil {
+			t.Errorf("running request middleware: %+v", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := replayer.Close(); err != nil {
+			t.Errorf("closing replayer: %+v", err)
+		}
+	}()
+	wg.Wait()
+
+	if err := replayer.Close(); err != nil {
+		t.Fatalf("closing replayer: %+v", err)
+	}
+}
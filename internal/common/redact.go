@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+const redactedValue = "REDACTED"
+
+// sensitiveHeaders are the (lower-cased) names of headers whose values are redacted when logging
+var sensitiveHeaders = map[string]struct{}{
+	"authorization":                  {},
+	"cookie":                         {},
+	"ocp-apim-subscription-key":      {},
+	"proxy-authorization":            {},
+	"set-cookie":                     {},
+	"x-functions-key":                {},
+	"x-ms-authorization-auxiliary":   {},
+	"x-ms-copy-source-authorization": {},
+	"x-ms-encryption-key":            {},
+}
+
+// sensitiveQueryParameters are the (lower-cased) names of query string parameters whose values are redacted when
+// logging, such as the signature of a SAS Token
+var sensitiveQueryParameters = map[string]struct{}{
+	"code": {},
+	"sig":  {},
+}
+
+// sensitiveProperties are the normalised names of JSON properties whose values are redacted when logging and
+// recording, in addition to those registered from the sensitive attributes within the Provider schema
+var sensitiveProperties = map[string]struct{}{
+	"accesskey":                  {},
+	"accesstoken":                {},
+	"accountkey":                 {},
+	"key":                        {},
+	"key1":                       {},
+	"key2":                       {},
+	"primarykey":                 {},
+	"primarymasterkey":           {},
+	"primaryreadonlymasterkey":   {},
+	"refreshtoken":               {},
+	"sastoken":                   {},
+	"secondarykey":               {},
+	"secondarymasterkey":         {},
+	"secondaryreadonlymasterkey": {},
+	"sharedaccesssignature":      {},
+	"sharedkey":                  {},
+	"storageaccountaccesskey":    {},
+	"storageaccountkey":          {},
+	"token":                      {},
+
+	// Key Vault Secrets and the keys returned from `listKeys` are returned in `value` - note that only strings
+	// are redacted, since `value` also contains the items within a list response
+	"value": {},
+}
+
+// sensitivePropertySuffixes are the normalised suffixes of JSON properties whose values are redacted when logging
+// and recording
+var sensitivePropertySuffixes = []string{
+	"connectionstring",
+	"password",
+	"secret",
+}
+
+// ignoredSchemaProperties are identifiers which are marked as sensitive within some schemas (e.g. the `host` within
+// a Kubernetes Cluster's `kube_config`), but are never registered since redacting them makes logs far less useful
+var ignoredSchemaProperties = map[string]struct{}{
+	"clientid":       {},
+	"host":           {},
+	"id":             {},
+	"name":           {},
+	"objectid":       {},
+	"principalid":    {},
+	"subscriptionid": {},
+	"tenant":         {},
+	"tenantid":       {},
+	"type":           {},
+	"uri":            {},
+	"username":       {},
+}
+
+var sensitivePropertiesLock = &sync.RWMutex{}
+
+// RegisterSensitiveProperties registers the names of attributes marked as sensitive within the Provider schema,
+// so that JSON properties with the same name are redacted when logging. Names are compared case-insensitively
+// and without underscores, so `primary_access_key` matches the JSON property `primaryAccessKey`.
+func RegisterSensitiveProperties(names ...string) {
+	sensitivePropertiesLock.Lock()
+	defer sensitivePropertiesLock.Unlock()
+
+	for _, name := range names {
+		v := normalisePropertyName(name)
+		if _, ignored := ignoredSchemaProperties[v]; ignored || v == "" {
+			continue
+		}
+		sensitiveProperties[v] = struct{}{}
+	}
+}
+
+func normalisePropertyName(input string) string {
+	return strings.ToLower(strings.ReplaceAll(input, "_", ""))
+}
+
+func isSensitiveProperty(name string) bool {
+	name = normalisePropertyName(name)
+
+	sensitivePropertiesLock.RLock()
+	_, ok := sensitiveProperties[name]
+	sensitivePropertiesLock.RUnlock()
+	if ok {
+		return true
+	}
+
+	for _, suffix := range sensitivePropertySuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactHeaders returns a copy of the headers with the values of any sensitive headers redacted
+func redactHeaders(input http.Header) http.Header {
+	output := input.Clone()
+	for k := range output {
+		if _, ok := sensitiveHeaders[strings.ToLower(k)]; ok {
+			output.Set(k, redactedValue)
+		}
+	}
+
+	return output
+}
+
+// redactQueryString redacts the values of any sensitive query string parameters, without otherwise re-encoding
+// the query string
+func redactQueryString(input string) string {
+	if input == "" {
+		return input
+	}
+
+	parameters := strings.Split(input, "&")
+	for i, parameter := range parameters {
+		name, _, ok := strings.Cut(parameter, "=")
+		if !ok {
+			continue
+		}
+		if _, sensitive := sensitiveQueryParameters[strings.ToLower(name)]; sensitive {
+			parameters[i] = name + "=" + redactedValue
+		}
+	}
+
+	return strings.Join(parameters, "&")
+}
+
+// redactBody redacts the values of any sensitive properties within a JSON body - bodies which aren't JSON are
+// returned as-is
+func redactBody(input []byte) []byte {
+	if len(bytes.TrimSpace(input)) == 0 {
+		return input
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return input
+	}
+
+	output, err := json.Marshal(redactValue(body))
+	if err != nil {
+		return input
+	}
+
+	return output
+}
+
+func redactValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, isString := value.(string); isString && isSensitiveProperty(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+		return v
+	}
+
+	return input
+}
+
+// dumpRequest returns the wire format of the request with any secrets redacted, leaving the request unchanged
+func dumpRequest(request *http.Request) ([]byte, error) {
+	body, err := readAndRestoreBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted := request.Clone(request.Context())
+	redacted.Header = redactHeaders(request.Header)
+	redacted.URL.RawQuery = redactQueryString(request.URL.RawQuery)
+	redacted.Body = nil
+	redacted.ContentLength = 0
+	if body != nil {
+		redactedBody := redactBody(body)
+		redacted.Body = io.NopCloser(bytes.NewReader(redactedBody))
+		redacted.ContentLength = int64(len(redactedBody))
+	}
+
+	return httputil.DumpRequestOut(redacted, true)
+}
+
+// dumpResponse returns the wire format of the response with any secrets redacted, leaving the response unchanged
+func dumpResponse(response *http.Response) ([]byte, error) {
+	body, err := readAndRestoreBody(&response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	redacted := *response
+	redacted.Header = redactHeaders(response.Header)
+	redacted.Body = nil
+	redacted.ContentLength = 0
+	redacted.TransferEncoding = nil
+	if body != nil {
+		redactedBody := redactBody(body)
+		redacted.Body = io.NopCloser(bytes.NewReader(redactedBody))
+		redacted.ContentLength = int64(len(redactedBody))
+	}
+
+	return httputil.DumpResponse(&redacted, true)
+}
+
+func readAndRestoreBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	contents, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(contents))
+	if err != nil {
+		return nil, err
+	}
+
+	return contents, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			// not JSON
+			input:    "<Error><Code>NotFound</Code></Error>",
+			expected: "<Error><Code>NotFound</Code></Error>",
+		},
+		{
+			input:    `{"keys":[{"keyName":"key1","value":"c2VjcmV0","permissions":"FULL"}]}`,
+			expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"}]}`,
+		},
+		{
+			input:    `{"primaryConnectionString":"Endpoint=sb://example","primaryKey":"abc","enabled":true}`,
+			expected: `{"enabled":true,"primaryConnectionString":"REDACTED","primaryKey":"REDACTED"}`,
+		},
+		{
+			// lists are returned within `value`, which should not be redacted
+			input:    `{"value":[{"name":"example","properties":{"administratorLoginPassword":"P@ssw0rd","port":5432}}]}`,
+			expected: `{"value":[{"name":"example","properties":{"administratorLoginPassword":"REDACTED","port":5432}}]}`,
+		},
+	}
+
+	for _, v := range testData {
+		actual := string(redactBody([]byte(v.input)))
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestRedactQueryString(t *testing.T) {
+	actual := redactQueryString("sv=2022-11-02&sig=c2VjcmV0%3D&se=2030-01-01")
+	expected := "sv=2022-11-02&sig=REDACTED&se=2030-01-01"
+	if actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRegisterSensitiveProperties(t *testing.T) {
+	if isSensitiveProperty("customDomainVerificationId") {
+		t.Fatalf("expected `customDomainVerificationId` not to be sensitive")
+	}
+
+	RegisterSensitiveProperties("custom_domain_verification_id")
+
+	if !isSensitiveProperty("customDomainVerificationId") {
+		t.Fatalf("expected `customDomainVerificationId` to be sensitive once registered")
+	}
+
+	RegisterSensitiveProperties("tenant_id")
+
+	if isSensitiveProperty("tenantId") {
+		t.Fatalf("expected `tenantId` not to be registered as sensitive")
+	}
+}
+
+func TestDumpRequest(t *testing.T) {
+	body := `{"properties":{"adminPassword":"P@ssw0rd"}}`
+	request, err := http.NewRequest(http.MethodPut, "https://example.blob.core.windows.net/container?sig=c2VjcmV0&sv=2022-11-02", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Bearer token")
+
+	dump, err := dumpRequest(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"P@ssw0rd", "c2VjcmV0", "Bearer token"} {
+		if bytes.Contains(dump, []byte(secret)) {
+			t.Fatalf("expected %q to be redacted from:\n%s", secret, dump)
+		}
+	}
+
+	// the request itself should be unchanged
+	if request.Header.Get("Authorization") != "Bearer token" {
+		t.Fatalf("expected the Authorization header to be unchanged")
+	}
+	if request.URL.Query().Get("sig") != "c2VjcmV0" {
+		t.Fatalf("expected the query string to be unchanged")
+	}
+	actual, err := io.ReadAll(request.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != body {
+		t.Fatalf("expected the body to be unchanged but got %q", actual)
+	}
+}
+
+func TestDumpResponse(t *testing.T) {
+	body := `{"value":"super-secret","id":"https://example.vault.azure.net/secrets/example"}`
+	response := &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Set-Cookie": []string{"session=abc"}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+
+	dump, err := dumpResponse(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"super-secret", "session=abc"} {
+		if bytes.Contains(dump, []byte(secret)) {
+			t.Fatalf("expected %q to be redacted from:\n%s", secret, dump)
+		}
+	}
+
+	actual, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != body {
+		t.Fatalf("expected the body to be unchanged but got %q", actual)
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	// DefaultRetryMaxAttempts matches the number of attempts used by go-autorest
+	DefaultRetryMaxAttempts = autorest.DefaultRetryAttempts
+
+	// DefaultRetryMaxBackoff is the longest time waited between two attempts when no backoff ceiling is configured
+	DefaultRetryMaxBackoff = 5 * time.Minute
+
+	// headerRateLimitRemainingPrefix prefixes the headers returned by Resource Manager containing the number of
+	// requests which can be made before requests are throttled, e.g. `x-ms-ratelimit-remaining-subscription-reads`
+	headerRateLimitRemainingPrefix = "X-Ms-Ratelimit-Remaining-"
+
+	// rateLimitRemainingThreshold is the number of remaining requests below which new requests are held back
+	rateLimitRemainingThreshold = 10
+
+	// rateLimitRemainingDelay is the time new requests are held back for each request below the threshold
+	rateLimitRemainingDelay = 500 * time.Millisecond
+)
+
+var subscriptionPathRegex = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+
+// RetryOptions configures how requests to Resource Manager which are throttled or fail with a transient error
+// are retried, and how quickly requests are sent to each Subscription.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of attempts made to send a request, including the first
+	MaxAttempts int
+
+	// MaxBackoff is the longest time waited between two attempts, including when a longer `Retry-After` is returned
+	MaxBackoff time.Duration
+
+	// RequestsPerSecond is the rate at which requests can be sent to each Subscription, zero means no limit
+	RequestsPerSecond float64
+
+	// Burst is the number of requests which can be sent to each Subscription at once, before RequestsPerSecond applies
+	Burst int
+}
+
+func (o RetryOptions) maxAttempts() int {
+	if o.MaxAttempts > 0 {
+		return o.MaxAttempts
+	}
+	return DefaultRetryMaxAttempts
+}
+
+func (o RetryOptions) maxBackoff() time.Duration {
+	if o.MaxBackoff > 0 {
+		return o.MaxBackoff
+	}
+	return DefaultRetryMaxBackoff
+}
+
+func (o RetryOptions) burst() int {
+	if o.Burst > 0 {
+		return o.Burst
+	}
+	// allow at least a second's worth of requests at once
+	return int(math.Max(1, math.Ceil(o.RequestsPerSecond)))
+}
+
+// backoff returns the time to wait before the next attempt, preferring the `Retry-After` header when present
+func (o RetryOptions) backoff(attempt int, response *http.Response) time.Duration {
+	wait, ok := retryAfter(response)
+	if !ok {
+		wait = time.Duration(math.Pow(2, float64(attempt))) * time.Second
+	}
+
+	if maxBackoff := o.maxBackoff(); wait > maxBackoff {
+		wait = maxBackoff
+	}
+
+	return wait
+}
+
+// subscriptionRateLimiters are shared between all clients within the process, since Resource Manager applies
+// its limits to the Subscription rather than to an individual client (or provider alias)
+var subscriptionRateLimiters = struct {
+	sync.Mutex
+	limiters map[string]*rateLimiter
+}{
+	limiters: make(map[string]*rateLimiter),
+}
+
+func rateLimiterForSubscription(subscriptionId string, options RetryOptions) *rateLimiter {
+	subscriptionRateLimiters.Lock()
+	defer subscriptionRateLimiters.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	limiter, ok := subscriptionRateLimiters.limiters[key]
+	if !ok {
+		limiter = &rateLimiter{}
+		subscriptionRateLimiters.limiters[key] = limiter
+	}
+
+	// the most recently configured limits apply, should multiple provider blocks target the same Subscription
+	limiter.configure(options.RequestsPerSecond, options.burst())
+
+	return limiter
+}
+
+// rateLimiter is a token bucket which can additionally be paused, for example when Resource Manager requests
+// that no further requests are made until a `Retry-After` has elapsed
+type rateLimiter struct {
+	mu sync.Mutex
+
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func (l *rateLimiter) configure(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.last.IsZero() {
+		l.tokens = float64(burst)
+		l.last = time.Now()
+	}
+	l.rate = rate
+	l.burst = float64(burst)
+	l.tokens = math.Min(l.tokens, l.burst)
+}
+
+// reserve takes a token from the bucket, returning how long the caller must wait before sending the request
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var wait time.Duration
+	if l.rate > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		l.tokens--
+
+		if l.tokens < 0 {
+			wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		}
+	}
+
+	if paused := l.pausedUntil.Sub(now); paused > wait {
+		wait = paused
+	}
+
+	return wait
+}
+
+// wait blocks until a request can be sent, or the context is cancelled
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting %s before sending request to Resource Manager", delay)
+	return sleep(ctx, delay)
+}
+
+// pause holds back all new requests for the duration
+func (l *rateLimiter) pause(duration time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(duration); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// drain empties the bucket, so that subsequent requests are sent at the configured rate
+func (l *rateLimiter) drain() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.tokens, 0)
+}
+
+// observe adjusts the limiter using the throttling headers returned by Resource Manager
+func (l *rateLimiter) observe(response *http.Response, options RetryOptions) {
+	if response == nil {
+		return
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		l.drain()
+		l.pause(options.backoff(0, response))
+		return
+	}
+
+	if remaining, ok := rateLimitRemaining(response); ok && remaining < rateLimitRemainingThreshold {
+		log.Printf("[DEBUG] Resource Manager reports %d requests remaining before throttling, slowing down", remaining)
+		l.drain()
+		l.pause(time.Duration(rateLimitRemainingThreshold-remaining) * rateLimitRemainingDelay)
+	}
+}
+
+// rateLimitRemaining returns the lowest number of remaining requests from the `x-ms-ratelimit-remaining-*` headers
+func rateLimitRemaining(response *http.Response) (int, bool) {
+	found := false
+	lowest := math.MaxInt
+	for header, values := range response.Header {
+		if !strings.HasPrefix(http.CanonicalHeaderKey(header), headerRateLimitRemainingPrefix) {
+			continue
+		}
+
+		for _, value := range values {
+			// resource-specific limits are formatted as `{Policy};{Remaining}`, optionally comma separated
+			for _, item := range strings.Split(value, ",") {
+				item = item[strings.LastIndex(item, ";")+1:]
+				if remaining, err := strconv.Atoi(strings.TrimSpace(item)); err == nil {
+					found = true
+					lowest = min(lowest, remaining)
+				}
+			}
+		}
+	}
+
+	return lowest, found
+}
+
+// retryAfter parses the `Retry-After` header, which is either a number of seconds or an HTTP date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+func isRetryableStatusCode(statusCode int) bool {
+	for _, v := range autorest.StatusCodesForRetry {
+		if statusCode == v {
+			return true
+		}
+	}
+	return false
+}
+
+// throttling applies the RetryOptions to requests sent to Resource Manager. Requests sent by go-autorest clients are
+// retried by the autorestSender, whereas go-azure-sdk clients retry throttled requests themselves (honouring any
+// `Retry-After`) so only the rate limiting applies to them.
+type throttling struct {
+	options               RetryOptions
+	resourceManagerHost   string
+	defaultSubscriptionId string
+}
+
+func newThrottling(options RetryOptions, resourceManagerEndpoint, subscriptionId string) *throttling {
+	host := ""
+	if u, err := url.Parse(resourceManagerEndpoint); err == nil {
+		host = strings.ToLower(u.Host)
+	}
+
+	return &throttling{
+		options:               options,
+		resourceManagerHost:   host,
+		defaultSubscriptionId: subscriptionId,
+	}
+}
+
+// rateLimiter returns the limiter for the Subscription the request targets, or nil when the request isn't sent
+// to Resource Manager
+func (t *throttling) rateLimiter(request *http.Request) *rateLimiter {
+	if t.resourceManagerHost == "" || !strings.EqualFold(request.URL.Host, t.resourceManagerHost) {
+		return nil
+	}
+
+	subscriptionId := t.defaultSubscriptionId
+	if match := subscriptionPathRegex.FindStringSubmatch(request.URL.Path); len(match) == 2 {
+		subscriptionId = match[1]
+	}
+	if subscriptionId == "" {
+		return nil
+	}
+
+	return rateLimiterForSubscription(subscriptionId, t.options)
+}
+
+// requestMiddleware waits for the Subscription's rate limiter before a go-azure-sdk client sends the request
+func (t *throttling) requestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		limiter := t.rateLimiter(request)
+		if limiter == nil {
+			return request, nil
+		}
+
+		if err := limiter.wait(request.Context()); err != nil {
+			return nil, fmt.Errorf("waiting to send request: %+v", err)
+		}
+
+		return request, nil
+	}
+}
+
+// responseMiddleware updates the Subscription's rate limiter from the throttling headers within the response
+// returned to a go-azure-sdk client, so that subsequent requests are held back
+func (t *throttling) responseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		// when replaying, the Recorder restores the original request onto the response
+		if response != nil && response.Request != nil {
+			request = response.Request
+		}
+
+		if limiter := t.rateLimiter(request); limiter != nil {
+			limiter.observe(response, t.options)
+		}
+
+		return response, nil
+	}
+}
+
+// autorestSender wraps the go-autorest Sender so that each attempt waits for the Subscription's rate limiter, and
+// any `Retry-After` header is capped to the configured backoff ceiling before go-autorest honours it
+func (t *throttling) autorestSender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		limiter := t.rateLimiter(request)
+		if limiter == nil {
+			return sender.Do(request)
+		}
+
+		if err := limiter.wait(request.Context()); err != nil {
+			return nil, fmt.Errorf("waiting to send request: %+v", err)
+		}
+
+		response, err := sender.Do(request)
+		limiter.observe(response, t.options)
+
+		if wait, ok := retryAfter(response); ok && wait > t.options.maxBackoff() {
+			response.Header.Set("Retry-After", strconv.Itoa(int(t.options.maxBackoff().Seconds())))
+		}
+
+		return response, err
+	})
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestRateLimitRemaining(t *testing.T) {
+	testData := []struct {
+		headers  http.Header
+		expected int
+		found    bool
+	}{
+		{
+			headers: http.Header{},
+			found:   false,
+		},
+		{
+			headers: http.Header{
+				"X-Ms-Ratelimit-Remaining-Subscription-Reads":  []string{"11999"},
+				"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"1199"},
+			},
+			expected: 1199,
+			found:    true,
+		},
+		{
+			headers: http.Header{
+				"X-Ms-Ratelimit-Remaining-Resource": []string{"Microsoft.Compute/HighCostGet3Min;107,Microsoft.Compute/HighCostGet30Min;4"},
+			},
+			expected: 4,
+			found:    true,
+		},
+		{
+			headers: http.Header{
+				"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"not-a-number"},
+			},
+			found: false,
+		},
+	}
+
+	for _, v := range testData {
+		actual, found := rateLimitRemaining(&http.Response{Header: v.headers})
+		if found != v.found {
+			t.Fatalf("expected found to be %t but got %t for %+v", v.found, found, v.headers)
+		}
+		if found && actual != v.expected {
+			t.Fatalf("expected %d but got %d for %+v", v.expected, actual, v.headers)
+		}
+	}
+}
+
+func TestRetryOptionsBackoff(t *testing.T) {
+	options := RetryOptions{
+		MaxBackoff: 10 * time.Second,
+	}
+
+	if actual := options.backoff(1, &http.Response{Header: http.Header{}}); actual != 2*time.Second {
+		t.Fatalf("expected an exponential backoff of 2s but got %s", actual)
+	}
+
+	if actual := options.backoff(0, &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}); actual != 5*time.Second {
+		t.Fatalf("expected the `Retry-After` of 5s to be honoured but got %s", actual)
+	}
+
+	if actual := options.backoff(0, &http.Response{Header: http.Header{"Retry-After": []string{"600"}}}); actual != 10*time.Second {
+		t.Fatalf("expected the `Retry-After` to be capped at 10s but got %s", actual)
+	}
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := &rateLimiter{}
+	limiter.configure(2, 2)
+
+	now := limiter.last
+	for i := 0; i < 2; i++ {
+		if wait := limiter.reserve(now); wait != 0 {
+			t.Fatalf("expected request %d to be sent immediately but got a wait of %s", i, wait)
+		}
+	}
+
+	if wait := limiter.reserve(now); wait != 500*time.Millisecond {
+		t.Fatalf("expected a wait of 500ms once the burst is exhausted but got %s", wait)
+	}
+
+	// a second later two tokens have been added, one of which repays the previous reservation
+	if wait := limiter.reserve(now.Add(time.Second)); wait != 0 {
+		t.Fatalf("expected the bucket to have refilled but got a wait of %s", wait)
+	}
+
+	limiter.pause(time.Minute)
+	if wait := limiter.reserve(time.Now()); wait < 59*time.Second {
+		t.Fatalf("expected the paused limiter to wait around a minute but got %s", wait)
+	}
+}
+
+// newThrottledTestClient returns a go-azure-sdk client for server, configured with the retry options
+func newThrottledTestClient(server *httptest.Server, subscriptionId string, options RetryOptions) *client.Client {
+	c := client.NewClient(server.URL, "test", "2020-01-01")
+	ClientOptions{
+		ResourceManagerEndpoint: server.URL,
+		SubscriptionId:          subscriptionId,
+		Retry:                   &options,
+	}.Configure(c, nil)
+	return c
+}
+
+func executeTestRequest(c *client.Client, method, path string, payload interface{}) (*client.Response, error) {
+	req, err := c.NewRequest(context.Background(), client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          method,
+		Path:                path,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if payload != nil {
+		if err := req.Marshal(payload); err != nil {
+			return nil, err
+		}
+	}
+	return c.Execute(context.Background(), req)
+}
+
+func TestThrottlingRateLimitsRequests(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	subscriptionId := "11111111-2222-3333-4444-000000000001"
+	c := newThrottledTestClient(server, subscriptionId, RetryOptions{
+		RequestsPerSecond: 5,
+		Burst:             1,
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := executeTestRequest(c, http.MethodGet, "/subscriptions/"+subscriptionId, nil); err != nil {
+			t.Fatalf("sending request %d: %+v", i, err)
+		}
+	}
+
+	if v := requests.Load(); v != 3 {
+		t.Fatalf("expected 3 requests but got %d", v)
+	}
+	// the first request uses the burst, the other two each wait 200ms for the limiter
+	if elapsed := time.Since(start); elapsed < 350*time.Millisecond {
+		t.Fatalf("expected each request to wait for the rate limiter but took %s", elapsed)
+	}
+}
+
+func TestThrottlingHoldsBackRequestsWhenFewRemain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// one below the threshold, so subsequent requests are held back for 500ms
+		w.Header().Set("X-Ms-Ratelimit-Remaining-Subscription-Reads", strconv.Itoa(rateLimitRemainingThreshold-1))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	subscriptionId := "11111111-2222-3333-4444-000000000003"
+	c := newThrottledTestClient(server, subscriptionId, RetryOptions{})
+
+	if _, err := executeTestRequest(c, http.MethodGet, "/subscriptions/"+subscriptionId, nil); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	start := time.Now()
+	if _, err := executeTestRequest(c, http.MethodPut, "/subscriptions/"+subscriptionId+"/resourceGroups/example", map[string]string{"location": "westeurope"}); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Fatalf("expected the request to be held back but took %s", elapsed)
+	}
+}
+
+func TestThrottlingIgnoresOtherHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ms-Ratelimit-Remaining-Subscription-Reads", "0")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	subscriptionId := "11111111-2222-3333-4444-000000000002"
+	retry := newThrottling(RetryOptions{}, "https://management.azure.com", subscriptionId)
+
+	request, _ := http.NewRequest(http.MethodGet, server.URL+"/subscriptions/"+subscriptionId, nil)
+	request, err := retry.requestMiddleware()(request)
+	if err != nil {
+		t.Fatalf("running request middleware: %+v", err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer response.Body.Close()
+
+	if _, err := retry.responseMiddleware()(request, response); err != nil {
+		t.Fatalf("running response middleware: %+v", err)
+	}
+
+	if wait := rateLimiterForSubscription(subscriptionId, RetryOptions{}).reserve(time.Now()); wait != 0 {
+		t.Fatalf("expected responses from other hosts to be ignored, but requests are held back for %s", wait)
+	}
+}
+
+func TestThrottlingAutorestSender(t *testing.T) {
+	subscriptionId := "11111111-2222-3333-4444-000000000004"
+	retry := newThrottling(RetryOptions{MaxBackoff: 10 * time.Second}, "https://management.azure.com", subscriptionId)
+
+	attempts := 0
+	sender := retry.autorestSender(autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		attempts++
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header: http.Header{
+				"Retry-After": []string{"600"},
+			},
+			Body:    http.NoBody,
+			Request: request,
+		}, nil
+	}))
+
+	request, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/"+subscriptionId, nil)
+	response, err := sender.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("expected the sender to send a single attempt but got %d", attempts)
+	}
+	if v := response.Header.Get("Retry-After"); v != "10" {
+		t.Fatalf("expected the `Retry-After` to be capped at 10 but got %q", v)
+	}
+	// the Subscription is paused for the (capped) `Retry-After`
+	if wait := rateLimiterForSubscription(subscriptionId, RetryOptions{}).reserve(time.Now()); wait < 9*time.Second || wait > 10*time.Second {
+		t.Fatalf("expected subsequent requests to be held back for 10s but got %s", wait)
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import "context"
+
+type holderContextKey struct{}
+
+// WithHolder returns a context recording the ID of the resource acquiring locks, so that the resource holding a
+// lock can be logged, and included in the error returned, when another resource times out waiting for it
+func WithHolder(ctx context.Context, resourceId string) context.Context {
+	return context.WithValue(ctx, holderContextKey{}, resourceId)
+}
+
+func holderFromContext(ctx context.Context, key string) string {
+	if v, ok := ctx.Value(holderContextKey{}).(string); ok && v != "" {
+		return v
+	}
+
+	return key
+}
@@ -3,7 +3,10 @@
 
 package locks
 
-import "slices"
+import (
+	"context"
+	"slices"
+)
 
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = newMutexKV()
@@ -18,6 +21,19 @@ func ByName(name string, resourceType string) {
 	armMutexKV.Lock(updatedName)
 }
 
+// ByIDWithContext acquires the lock for the given ID, returning a LockTimeoutError if the context (typically
+// the timeout for the current operation) expires before the lock can be acquired
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
+// ByNameWithContext acquires the lock for the given name and resource type, returning a LockTimeoutError if the
+// context (typically the timeout for the current operation) expires before the lock can be acquired
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return armMutexKV.LockWithContext(ctx, updatedName)
+}
+
 func MultipleByID(ids *[]string) {
 	newSlice := removeDuplicatesFromStringArray(*ids)
 
@@ -38,6 +54,44 @@ func MultipleByName(names *[]string, resourceType string) {
 	}
 }
 
+// MultipleByIDWithContext acquires the locks for the given IDs, releasing any locks already acquired when the
+// context expires before all of the locks can be acquired
+func MultipleByIDWithContext(ctx context.Context, ids *[]string) error {
+	newSlice := removeDuplicatesFromStringArray(*ids)
+
+	slices.Sort(newSlice)
+
+	for i, id := range newSlice {
+		if err := ByIDWithContext(ctx, id); err != nil {
+			for _, acquired := range newSlice[:i] {
+				UnlockByID(acquired)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MultipleByNameWithContext acquires the locks for the given names, releasing any locks already acquired when the
+// context expires before all of the locks can be acquired
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	newSlice := removeDuplicatesFromStringArray(*names)
+
+	slices.Sort(newSlice)
+
+	for i, name := range newSlice {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			for _, acquired := range newSlice[:i] {
+				UnlockByName(acquired, resourceType)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
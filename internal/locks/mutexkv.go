@@ -4,48 +4,154 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
+// contentionLogInterval is how often a warning is logged whilst waiting to acquire a lock which is held elsewhere
+var contentionLogInterval = 30 * time.Second
+
+// LockTimeoutError is returned when the context expires before a lock can be acquired
+type LockTimeoutError struct {
+	Key       string
+	Holder    string
+	HeldFor   time.Duration
+	WaitedFor time.Duration
+	Err       error
+}
+
+func (e LockTimeoutError) Error() string {
+	if e.Holder == "" {
+		return fmt.Sprintf("waited %s to acquire the lock %q: %+v", e.WaitedFor, e.Key, e.Err)
+	}
+
+	return fmt.Sprintf("waited %s to acquire the lock %q, which has been held by %q for %s: %+v", e.WaitedFor, e.Key, e.Holder, e.HeldFor, e.Err)
+}
+
+func (e LockTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// mutex is a lock which can be acquired using a context, and which records who holds it
+type mutex struct {
+	sem chan struct{}
+
+	// holder and acquiredAt are guarded by the lock within the mutexKV
+	holder     string
+	acquiredAt time.Time
+}
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*mutex
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// a context without a deadline can't be cancelled, so an error can't be returned here
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning a LockTimeoutError if the context expires first.
+// Whilst waiting, the holder of the lock and how long it's been held is logged periodically. Caller is responsible
+// for calling Unlock for the same key when no error is returned.
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	mu := m.get(key)
+	start := time.Now()
+
+	select {
+	case mu.sem <- struct{}{}:
+	default:
+		ticker := time.NewTicker(contentionLogInterval)
+		defer ticker.Stop()
+
+	wait:
+		for {
+			select {
+			case mu.sem <- struct{}{}:
+				break wait
+
+			case <-ticker.C:
+				holder, heldFor := m.holder(mu)
+				log.Printf("[WARN] Waited %s to lock %q, which has been held by %q for %s", time.Since(start).Round(time.Second), key, holder, heldFor.Round(time.Second))
+
+			case <-ctx.Done():
+				holder, heldFor := m.holder(mu)
+				return LockTimeoutError{
+					Key:       key,
+					Holder:    holder,
+					HeldFor:   heldFor.Round(time.Second),
+					WaitedFor: time.Since(start).Round(time.Second),
+					Err:       ctx.Err(),
+				}
+			}
+		}
+	}
+
+	m.lock.Lock()
+	mu.holder = holderFromContext(ctx, key)
+	mu.acquiredAt = time.Now()
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Locked %q after %s", key, time.Since(start).Round(time.Millisecond))
+	return nil
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	mu := m.get(key)
+
+	m.lock.Lock()
+	mu.holder = ""
+	mu.acquiredAt = time.Time{}
+	m.lock.Unlock()
+
+	select {
+	case <-mu.sem:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked mutex %q", key))
+	}
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *mutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+	mu, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		mu = &mutex{
+			sem: make(chan struct{}, 1),
+		}
+		m.store[key] = mu
+	}
+	return mu
+}
+
+// holder returns who holds the mutex and for how long, which is empty when the mutex is not held
+func (m *mutexKV) holder(mu *mutex) (string, time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if mu.acquiredAt.IsZero() {
+		return mu.holder, 0
 	}
-	return mutex
+
+	return mu.holder, time.Since(mu.acquiredAt)
 }
 
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*mutex),
 	}
 }
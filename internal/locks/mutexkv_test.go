@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package locks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMutexKVLockWithContext(t *testing.T) {
+	m := newMutexKV()
+	key := "azurerm_virtual_network.example"
+
+	holderId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/first"
+	if err := m.LockWithContext(WithHolder(context.Background(), holderId), key); err != nil {
+		t.Fatalf("acquiring an unheld lock: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := m.LockWithContext(ctx, key)
+	if err == nil {
+		t.Fatalf("expected an error acquiring a held lock")
+	}
+
+	var timeoutErr LockTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a LockTimeoutError but got %T: %+v", err, err)
+	}
+	if timeoutErr.Holder != holderId {
+		t.Fatalf("expected the holder to be %q but got %q", holderId, timeoutErr.Holder)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the error to wrap context.DeadlineExceeded: %+v", err)
+	}
+	if !strings.Contains(err.Error(), holderId) {
+		t.Fatalf("expected the error to contain the holder: %+v", err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- m.LockWithContext(context.Background(), key)
+	}()
+
+	m.Unlock(key)
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("acquiring a released lock: %+v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the released lock to be acquired")
+	}
+
+	if holder, _ := m.holder(m.get(key)); holder != key {
+		t.Fatalf("expected the holder to default to the key but got %q", holder)
+	}
+	m.Unlock(key)
+}
+
+func TestMultipleByNameWithContextReleasesOnTimeout(t *testing.T) {
+	resourceType := "azurerm_network_security_group"
+	ByName("second", resourceType)
+	defer UnlockByName("second", resourceType)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := MultipleByNameWithContext(ctx, &[]string{"second", "first"}, resourceType); err == nil {
+		t.Fatalf("expected an error acquiring a held lock")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// the lock on `first` should have been released when acquiring `second` timed out
+	if err := ByNameWithContext(ctx, "first", resourceType); err != nil {
+		t.Fatalf("expected the lock acquired before the timeout to have been released: %+v", err)
+	}
+	UnlockByName("first", resourceType)
+}
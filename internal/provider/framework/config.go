@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
@@ -522,10 +523,37 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	}
 
 	p.clientBuilder.Features = f
+
+	if !data.Retry.IsNull() && !data.Retry.IsUnknown() {
+		var retryList []Retry
+		d := data.Retry.ElementsAs(ctx, &retryList, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(retryList) > 0 {
+			retry := retryList[0]
+			p.clientBuilder.Retry = &common.RetryOptions{
+				MaxAttempts:       int(retry.MaxAttempts.ValueInt64()),
+				MaxBackoff:        time.Duration(retry.MaxBackoffInSeconds.ValueInt64()) * time.Second,
+				RequestsPerSecond: retry.RequestsPerSecond.ValueFloat64(),
+				Burst:             int(retry.Burst.ValueInt64()),
+			}
+		}
+	}
+
 	p.clientBuilder.AuthConfig = authConfig
 	p.clientBuilder.CustomCorrelationRequestID = os.Getenv("ARM_CORRELATION_REQUEST_ID")
 	p.clientBuilder.TerraformVersion = tfVersion
 
+	recorder, err := common.NewRecorderFromEnvironment()
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("configuring recording", err.Error()))
+		return
+	}
+	p.clientBuilder.Recorder = recorder
+
 	client, err := clients.Build(ctx, p.clientBuilder)
 	if err != nil {
 		diags.Append(diag.NewErrorDiagnostic("building client", err.Error()))
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -45,9 +46,37 @@ func ProtoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 	return factories
 }
 
+// ProtoV5ProviderFactoriesWithRecorderInit returns Provider Factories which record or replay all HTTP traffic
+// using the specified Recorder.
+func ProtoV5ProviderFactoriesWithRecorderInit(ctx context.Context, recorder *common.Recorder, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
+	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
+
+	for _, name := range providerNames {
+		factories[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, err := protoV5ProviderServerFactory(ctx, provider.AzureProviderWithRecorder(recorder))
+			if err != nil {
+				return nil, err
+			}
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return factories
+}
+
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
 	v2Provider := provider.AzureProvider()
 
+	providerServerFactory, err := protoV5ProviderServerFactory(ctx, v2Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return providerServerFactory, v2Provider, nil
+}
+
+func protoV5ProviderServerFactory(ctx context.Context, v2Provider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
 	providers := []func() tfprotov5.ProviderServer{
 		v2Provider.GRPCProvider,
 		providerserver.NewProtocol5(NewFrameworkProvider(v2Provider)),
@@ -55,10 +84,10 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return muxServer.ProviderServer, v2Provider, nil
+	return muxServer.ProviderServer, nil
 }
 
 func V5ProviderWithoutPluginSDK() func() tfprotov5.ProviderServer {
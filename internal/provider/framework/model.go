@@ -37,6 +37,7 @@ type ProviderModel struct {
 	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
 	Features                       types.List   `tfsdk:"features"`
+	Retry                          types.List   `tfsdk:"retry"`
 	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister    types.List   `tfsdk:"resource_providers_to_register"`
@@ -281,3 +282,17 @@ type DatabricksWorkspace struct {
 var DatabricksWorkspaceAttributes = map[string]attr.Type{
 	"force_delete": types.BoolType,
 }
+
+type Retry struct {
+	MaxAttempts         types.Int64   `tfsdk:"max_attempts"`
+	MaxBackoffInSeconds types.Int64   `tfsdk:"max_backoff_in_seconds"`
+	RequestsPerSecond   types.Float64 `tfsdk:"requests_per_second"`
+	Burst               types.Int64   `tfsdk:"burst"`
+}
+
+var RetryAttributes = map[string]attr.Type{
+	"max_attempts":           types.Int64Type,
+	"max_backoff_in_seconds": types.Int64Type,
+	"requests_per_second":    types.Float64Type,
+	"burst":                  types.Int64Type,
+}
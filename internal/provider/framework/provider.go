@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
@@ -505,6 +507,48 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 					},
 				},
 			},
+
+			"retry": schema.ListNestedBlock{
+				Description: "Configures how requests to Resource Manager are retried when throttled or failing, and how quickly requests are sent to each Subscription.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts made to send a request which is throttled or fails with a transient error, including the first attempt. Requests sent by `go-azure-sdk` clients use the SDK's own retry policy.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+
+						"max_backoff_in_seconds": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of seconds to wait between two attempts, including when Resource Manager returns a longer `Retry-After`.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The number of requests per second which can be sent to each Subscription. Defaults to `0`, meaning requests are not rate limited.",
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of requests which can be sent to each Subscription at once, before `requests_per_second` applies.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -584,14 +628,41 @@ func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() functio
 	return []func() function.Function{
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewResourceIDParentFunction,
+		providerfunction.NewResourceIDScopeFunction,
+		providerfunction.NewResourceIDsEqualFunction,
+		func() function.Function {
+			return providerfunction.NewValidateResourceNameFunction(resourceNameValidator)
+		},
 	}
 }
 
-func (p *azureRmFrameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+func (p *azureRmFrameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
 	var output []func() list.ListResource
+	registered := make(map[string]struct{})
 
 	for _, service := range pluginsdkprovider.SupportedFrameworkServices() {
-		output = append(output, service.ListResources()...)
+		for _, r := range service.ListResources() {
+			metadata := resource.MetadataResponse{}
+			r().Metadata(ctx, resource.MetadataRequest{}, &metadata)
+			registered[metadata.TypeName] = struct{}{}
+
+			output = append(output, r)
+		}
+	}
+
+	// Typed Resources exposing a Resource Identity can be listed generically, unless a List Resource already exists
+	for _, service := range pluginsdkprovider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if _, ok := registered[r.ResourceType()]; ok {
+				continue
+			}
+
+			if f, ok := sdk.NewGenericListResource(r); ok {
+				output = append(output, f)
+			}
+		}
 	}
 
 	return output
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	pluginsdkprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourceNameValidators is built on first use, since building the schema for every resource is expensive and
+// only needed when the `validate_resource_name` function is used
+var resourceNameValidators = sync.OnceValue(func() map[string]providerfunction.ResourceNameValidator {
+	output := make(map[string]providerfunction.ResourceNameValidator)
+
+	for resourceType, r := range pluginsdkprovider.AzureProvider().ResourcesMap {
+		output[resourceType] = pluginSdkNameValidator(r.Schema["name"])
+	}
+
+	for _, service := range pluginsdkprovider.SupportedFrameworkServices() {
+		for _, r := range service.FrameworkResources() {
+			response := resource.SchemaResponse{}
+			r.Schema(context.Background(), resource.SchemaRequest{}, &response)
+			output[r.ResourceType()] = frameworkNameValidator(response.Schema.Attributes["name"])
+		}
+	}
+
+	return output
+})
+
+func resourceNameValidator(resourceType string) (providerfunction.ResourceNameValidator, bool) {
+	v, ok := resourceNameValidators()[resourceType]
+	return v, ok
+}
+
+func pluginSdkNameValidator(s *pluginsdk.Schema) providerfunction.ResourceNameValidator {
+	if s == nil || (s.ValidateFunc == nil && s.ValidateDiagFunc == nil) {
+		return nil
+	}
+
+	return func(_ context.Context, name string) []string {
+		problems := make([]string, 0)
+
+		if s.ValidateFunc != nil {
+			_, errs := s.ValidateFunc(name, "name")
+			for _, err := range errs {
+				problems = append(problems, err.Error())
+			}
+		}
+
+		if s.ValidateDiagFunc != nil {
+			for _, d := range s.ValidateDiagFunc(name, cty.GetAttrPath("name")) {
+				if d.Severity != diag.Error {
+					continue
+				}
+				problems = append(problems, diagnosticMessage(d.Summary, d.Detail))
+			}
+		}
+
+		return problems
+	}
+}
+
+func frameworkNameValidator(attribute schema.Attribute) providerfunction.ResourceNameValidator {
+	s, ok := attribute.(schema.StringAttribute)
+	if !ok || len(s.Validators) == 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, name string) []string {
+		problems := make([]string, 0)
+
+		for _, v := range s.Validators {
+			response := validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{
+				Path:        path.Root("name"),
+				ConfigValue: types.StringValue(name),
+			}, &response)
+
+			for _, d := range response.Diagnostics.Errors() {
+				problems = append(problems, diagnosticMessage(d.Summary(), d.Detail()))
+			}
+		}
+
+		return problems
+	}
+}
+
+func diagnosticMessage(summary, detail string) string {
+	if detail == "" {
+		return summary
+	}
+
+	return fmt.Sprintf("%s: %s", summary, detail)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestPluginSdkNameValidator(t *testing.T) {
+	if v := pluginSdkNameValidator(nil); v != nil {
+		t.Fatalf("expected no validator for a resource without a `name`")
+	}
+
+	if v := pluginSdkNameValidator(&pluginsdk.Schema{Type: pluginsdk.TypeString, Required: true}); v != nil {
+		t.Fatalf("expected no validator for a `name` without validation")
+	}
+
+	v := pluginSdkNameValidator(&pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 5),
+	})
+	if v == nil {
+		t.Fatalf("expected a validator for a `name` with validation")
+	}
+
+	if problems := v(context.Background(), "valid"); len(problems) != 0 {
+		t.Fatalf("expected no problems for a valid name but got %+v", problems)
+	}
+	if problems := v(context.Background(), "too-long"); len(problems) != 1 {
+		t.Fatalf("expected a problem for an invalid name but got %+v", problems)
+	}
+}
+
+func TestFrameworkNameValidator(t *testing.T) {
+	if v := frameworkNameValidator(nil); v != nil {
+		t.Fatalf("expected no validator for a resource without a `name`")
+	}
+
+	if v := frameworkNameValidator(schema.StringAttribute{Required: true}); v != nil {
+		t.Fatalf("expected no validator for a `name` without validation")
+	}
+
+	if v := frameworkNameValidator(schema.Int64Attribute{Required: true}); v != nil {
+		t.Fatalf("expected no validator for a `name` which isn't a string")
+	}
+
+	v := frameworkNameValidator(schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 5),
+		},
+	})
+	if v == nil {
+		t.Fatalf("expected a validator for a `name` with validation")
+	}
+
+	if problems := v(context.Background(), "valid"); len(problems) != 0 {
+		t.Fatalf("expected no problems for a valid name but got %+v", problems)
+	}
+	if problems := v(context.Background(), "too-long"); len(problems) != 1 {
+		t.Fatalf("expected a problem for an invalid name but got %+v", problems)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (a BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (a BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID for a supported resource type from the values of its segments",
+		MarkdownDescription: "Builds an Azure Resource Manager ID for a supported resource type from the values of its segments",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The full resource type, e.g. Microsoft.Network/virtualNetworks/subnets",
+				MarkdownDescription: "The full resource type, e.g. `Microsoft.Network/virtualNetworks/subnets`",
+			},
+			function.MapParameter{
+				Name:                "segments",
+				ElementType:         types.StringType,
+				Description:         "The values for each segment of the Resource ID, e.g. subscription_id, resource_group_name and virtual_network_name",
+				MarkdownDescription: "The values for each segment of the Resource ID, e.g. `subscription_id`, `resource_group_name` and `virtual_network_name`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType string
+	var segments map[string]string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &segments))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(resourceType) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "Got empty resource type")
+		return
+	}
+
+	values := make(map[string]string, len(segments))
+	for k, v := range segments {
+		values[normaliseSegmentName(k)] = v
+	}
+
+	// the same resource type can be available at several scopes, so the ID type is chosen by the segments specified
+	candidates := make([]string, 0)
+	for _, key := range sortedKnownResourceIdKeys() {
+		idType := recaser.KnownResourceIds()[key]
+		if !strings.EqualFold(fullResourceType(idType.Segments()), resourceType) {
+			continue
+		}
+
+		names := make([]string, 0)
+		parsed := make(map[string]string)
+		matches := true
+		for _, segment := range idType.Segments() {
+			if segment.Type == resourceids.StaticSegmentType || segment.Type == resourceids.ResourceProviderSegmentType {
+				continue
+			}
+
+			names = append(names, segment.Name)
+			v, ok := values[normaliseSegmentName(segment.Name)]
+			if !ok || v == "" {
+				matches = false
+				continue
+			}
+			parsed[segment.Name] = v
+		}
+
+		if !matches || len(names) != len(values) {
+			candidates = append(candidates, strings.Join(names, ", "))
+			continue
+		}
+
+		id := buildIdFromSegments(idType.Segments(), parsed)
+		if _, _, err := parseKnownResourceId(id); err != nil {
+			response.Error = function.NewFuncError(fmt.Sprintf("validating the Resource ID %q built for %q: %+v", id, resourceType, err))
+			return
+		}
+
+		response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, id))
+		return
+	}
+
+	if len(candidates) == 0 {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("the resource type %q is not supported by the provider", resourceType))
+		return
+	}
+
+	response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the segments specified do not match a Resource ID for %q, expected values for one of: [%s]", resourceType, strings.Join(candidates, "], [")))
+}
+
+// sortedKnownResourceIdKeys returns the keys of the Resource IDs registered with the recaser, sorted so that
+// the ID type chosen for a resource type is deterministic
+func sortedKnownResourceIdKeys() []string {
+	keys := make([]string, 0)
+	for k := range recaser.KnownResourceIds() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildResourceID_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "subnet_id" {
+  value = provider::azurerm::build_resource_id("Microsoft.Network/virtualNetworks/subnets", {
+    subscription_id      = "12345678-1234-9876-4563-123456789012"
+    resource_group_name  = "resGroup1"
+    virtual_network_name = "network1"
+    subnet_name          = "subnet1"
+  })
+}
+
+output "resource_group_id" {
+  value = provider::azurerm::build_resource_id("Microsoft.Resources/resourceGroups", {
+    subscriptionId    = "12345678-1234-9876-4563-123456789012"
+    resourceGroupName = "resGroup1"
+  })
+}
+`,
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("subnet_id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"),
+					acceptance.TestCheckOutput("resource_group_id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// parseKnownResourceId parses the input into the matching Resource ID type registered with the recaser
+func parseKnownResourceId(id string) (resourceids.ResourceId, *resourceids.ParseResult, error) {
+	if len(id) == 0 {
+		return nil, nil, fmt.Errorf("got empty ID")
+	}
+
+	idType := recaser.ResourceIdTypeFromResourceId(id)
+	if idType == nil {
+		return nil, nil, fmt.Errorf("could not determine resource ID type from %s, ID may be malformed or currently not supported in the provider", id)
+	}
+
+	parser := resourceids.NewParserFromResourceIdType(idType)
+	parsed, err := parser.Parse(id, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing Resource ID: %+v", err)
+	}
+
+	if err := idType.FromParseResult(*parsed); err != nil {
+		return nil, nil, fmt.Errorf("expanding parsed Resource ID: %+v", err)
+	}
+
+	return idType, parsed, nil
+}
+
+// buildIdFromSegments builds a Resource ID from the segments, using the values in `parsed` for any segments
+// which are not fixed
+func buildIdFromSegments(segments []resourceids.Segment, parsed map[string]string) string {
+	components := make([]string, 0)
+	for _, segment := range segments {
+		switch segment.Type {
+		case resourceids.StaticSegmentType, resourceids.ResourceProviderSegmentType:
+			components = append(components, pointer.From(segment.FixedValue))
+
+		case resourceids.ScopeSegmentType:
+			if scope := strings.Trim(parsed[segment.Name], "/"); scope != "" {
+				components = append(components, scope)
+			}
+
+		default:
+			components = append(components, parsed[segment.Name])
+		}
+	}
+
+	return "/" + strings.Join(components, "/")
+}
+
+// fullResourceType returns the Resource Type for the segments, e.g. `Microsoft.ApiManagement/service/gateways`
+func fullResourceType(segments []resourceids.Segment) string {
+	output := ""
+	lastStaticSegment := ""
+	for _, segment := range segments {
+		switch segment.Type {
+		case resourceids.ResourceProviderSegmentType:
+			// extension resources are identified by the last Resource Provider within the ID
+			output = pointer.From(segment.FixedValue)
+
+		case resourceids.StaticSegmentType:
+			lastStaticSegment = pointer.From(segment.FixedValue)
+			if output != "" {
+				output = fmt.Sprintf("%s/%s", output, pointer.From(segment.FixedValue))
+			}
+		}
+	}
+
+	// Subscriptions and Resource Groups don't contain a Resource Provider, but are part of `Microsoft.Resources`
+	if output == "" && lastStaticSegment != "" {
+		output = fmt.Sprintf("Microsoft.Resources/%s", lastStaticSegment)
+	}
+
+	return output
+}
+
+// normaliseSegmentName allows segment names to be specified in either camelCase or snake_case
+func normaliseSegmentName(input string) string {
+	return strings.ToLower(strings.ReplaceAll(input, "_", ""))
+}
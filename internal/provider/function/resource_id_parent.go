@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type ResourceIDParentFunction struct{}
+
+var _ function.Function = ResourceIDParentFunction{}
+
+func NewResourceIDParentFunction() function.Function {
+	return &ResourceIDParentFunction{}
+}
+
+func (a ResourceIDParentFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_id_parent"
+}
+
+func (a ResourceIDParentFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_id_parent",
+		Description:         "Returns the ID of the parent of an Azure Resource Manager ID, such as the Virtual Network of a Subnet or the Resource Group of a top-level resource",
+		MarkdownDescription: "Returns the ID of the parent of an Azure Resource Manager ID, such as the Virtual Network of a Subnet or the Resource Group of a top-level resource",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a ResourceIDParentFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id))
+
+	if response.Error != nil {
+		return
+	}
+
+	idType, parsed, err := parseKnownResourceId(id)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	segments := idType.Segments()
+	if len(segments) < 2 {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s does not have a parent", id))
+		return
+	}
+	segments = segments[:len(segments)-2]
+
+	// the parent of a top-level resource is the scope it's deployed into, rather than the `providers/{namespace}` segments
+	if n := len(segments); n >= 2 && segments[n-1].Type == resourceids.ResourceProviderSegmentType {
+		segments = segments[:n-2]
+	}
+
+	if len(segments) == 0 {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%s does not have a parent", id))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, buildIdFromSegments(segments, parsed.Parsed)))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type ResourceIDScopeFunction struct{}
+
+var _ function.Function = ResourceIDScopeFunction{}
+
+func NewResourceIDScopeFunction() function.Function {
+	return &ResourceIDScopeFunction{}
+}
+
+func (a ResourceIDScopeFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_id_scope"
+}
+
+func (a ResourceIDScopeFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_id_scope",
+		Description:         "Returns the scope an Azure Resource Manager ID is deployed into, such as a Resource Group, Subscription or the Resource an extension resource is scoped to",
+		MarkdownDescription: "Returns the scope an Azure Resource Manager ID is deployed into, such as a Resource Group, Subscription or the Resource an extension resource is scoped to",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a ResourceIDScopeFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id))
+
+	if response.Error != nil {
+		return
+	}
+
+	idType, parsed, err := parseKnownResourceId(id)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	segments := idType.Segments()
+	for _, segment := range segments {
+		if segment.Type == resourceids.ScopeSegmentType {
+			response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, parsed.Parsed[segment.Name]))
+			return
+		}
+	}
+
+	// the scope is everything prior to the first `providers/{namespace}` segments - for IDs without a Resource
+	// Provider (e.g. a Resource Group) it's everything prior to the last two segments
+	end := len(segments) - 2
+	for i, segment := range segments {
+		if segment.Type == resourceids.ResourceProviderSegmentType {
+			end = i - 1
+			break
+		}
+	}
+
+	scope := "/"
+	if end > 0 {
+		scope = buildIdFromSegments(segments[:end], parsed.Parsed)
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, scope))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type ResourceIDsEqualFunction struct{}
+
+var _ function.Function = ResourceIDsEqualFunction{}
+
+func NewResourceIDsEqualFunction() function.Function {
+	return &ResourceIDsEqualFunction{}
+}
+
+func (a ResourceIDsEqualFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_ids_equal"
+}
+
+func (a ResourceIDsEqualFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_ids_equal",
+		Description:         "Compares two Azure Resource Manager IDs, ignoring differences in casing as Azure does",
+		MarkdownDescription: "Compares two Azure Resource Manager IDs, ignoring differences in casing as Azure does",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+			function.StringParameter{
+				Name:                "other_id",
+				Description:         "Resource ID to compare against",
+				MarkdownDescription: "Resource ID to compare against",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (a ResourceIDsEqualFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id, otherId string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id, &otherId))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(id) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "Got empty ID")
+		return
+	}
+	if len(otherId) == 0 {
+		response.Error = function.NewArgumentFuncError(1, "Got empty ID")
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, strings.EqualFold(normaliseForComparison(id), normaliseForComparison(otherId))))
+}
+
+// normaliseForComparison recases the known segments of the ID and ensures it has a leading `/`
+func normaliseForComparison(input string) string {
+	output := recaser.ReCase(strings.TrimSuffix(strings.TrimSpace(input), "/"))
+	if !strings.HasPrefix(output, "/") {
+		output = "/" + output
+	}
+
+	return output
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceNameValidator runs the validation for the `name` of a resource, returning the problems found
+type ResourceNameValidator func(ctx context.Context, name string) []string
+
+// ResourceNameValidatorLookup returns the ResourceNameValidator for a resource type, and whether the resource type
+// is supported - the ResourceNameValidator is nil when the resource has no `name` or doesn't validate it
+type ResourceNameValidatorLookup func(resourceType string) (ResourceNameValidator, bool)
+
+type ValidateResourceNameFunction struct {
+	lookup ResourceNameValidatorLookup
+}
+
+var _ function.Function = ValidateResourceNameFunction{}
+
+func NewValidateResourceNameFunction(lookup ResourceNameValidatorLookup) function.Function {
+	return &ValidateResourceNameFunction{
+		lookup: lookup,
+	}
+}
+
+func (a ValidateResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "validate_resource_name"
+}
+
+func (a ValidateResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "validate_resource_name",
+		Description:         "Validates a name using the same rules as the name argument of the specified resource, returning any problems found",
+		MarkdownDescription: "Validates a name using the same rules as the `name` argument of the specified resource, returning any problems found",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The resource type, e.g. azurerm_storage_account",
+				MarkdownDescription: "The resource type, e.g. `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The name to validate",
+				MarkdownDescription: "The name to validate",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (a ValidateResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	validate, ok := a.lookup(resourceType)
+	if !ok {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("the resource type %q is not supported by the provider", resourceType))
+		return
+	}
+
+	if validate == nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("the resource type %q has no name validation", resourceType))
+		return
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.StringType, validate(ctx, name))
+	if diags.HasError() {
+		response.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
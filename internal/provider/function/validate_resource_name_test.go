@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
+)
+
+func TestProviderFunctionValidateResourceName_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "valid" {
+  value = length(provider::azurerm::validate_resource_name("azurerm_storage_account", "examplestorageaccount"))
+}
+
+output "invalid" {
+  value = length(provider::azurerm::validate_resource_name("azurerm_storage_account", "Example-Storage-Account")) > 0
+}
+`,
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid", "0"),
+					acceptance.TestCheckOutput("invalid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_unsupportedResourceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::validate_resource_name("azurerm_does_not_exist", "example")
+}
+`,
+				ExpectError: regexp.MustCompile("is not supported by the provider"),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_noNameValidation(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				// this resource has no `name` argument
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "test" {
+  value = provider::azurerm::validate_resource_name("azurerm_function_app_active_slot", "example")
+}
+`,
+				ExpectError: regexp.MustCompile("has no name validation"),
+			},
+		},
+	})
+}
+
+func TestValidateResourceNameFunction_noNameValidation(t *testing.T) {
+	ctx := context.Background()
+
+	// resources without a `name`, or whose `name` isn't validated, have no validator
+	f := providerfunction.NewValidateResourceNameFunction(func(resourceType string) (providerfunction.ResourceNameValidator, bool) {
+		return nil, resourceType == "azurerm_example"
+	})
+
+	response := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.StringType)),
+	}
+	f.Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("azurerm_example"),
+			types.StringValue("example"),
+		}),
+	}, &response)
+
+	if response.Error == nil || !strings.Contains(response.Error.Error(), `the resource type "azurerm_example" has no name validation`) {
+		t.Fatalf("expected an error for a resource type without name validation but got %+v", response.Error)
+	}
+	if response.Error.FunctionArgument == nil || *response.Error.FunctionArgument != 0 {
+		t.Fatalf("expected the error to be for the `resource_type` argument but got %+v", response.Error.FunctionArgument)
+	}
+}
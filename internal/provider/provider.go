@@ -17,17 +17,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func AzureProvider() *schema.Provider {
-	return azureProvider(false)
+	return azureProvider(false, nil)
 }
 
 func TestAzureProvider() *schema.Provider {
-	return azureProvider(true)
+	return azureProvider(true, nil)
+}
+
+// AzureProviderWithRecorder returns the Provider configured to record or replay all HTTP traffic using the
+// specified Recorder, rather than one configured from the environment.
+func AzureProviderWithRecorder(recorder *common.Recorder) *schema.Provider {
+	return azureProvider(false, recorder)
 }
 
 func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
@@ -79,7 +86,7 @@ func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	}
 }
 
-func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
+func azureProvider(supportLegacyTestSuite bool, recorder *common.Recorder) *schema.Provider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
 
@@ -138,6 +145,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// ensure that the values of sensitive attributes are redacted when logging requests and responses
+	registerSensitiveAttributes(dataSources)
+	registerSensitiveAttributes(resources)
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -335,6 +346,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"retry": schemaRetry(),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -381,7 +394,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		ResourcesMap:   resources,
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	p.ConfigureContextFunc = providerConfigure(p, recorder)
 
 	return p
 }
@@ -389,7 +402,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 // providerConfigure is used to configure the cloud environment and authentication.
 // To configure behavioral aspects of the provider, use the buildClient function instead.
 // This separation allows us to robustly test different authentication scenarios.
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, recorder *common.Recorder) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		subscriptionId := d.Get("subscription_id").(string)
 		if subscriptionId == "" {
@@ -494,13 +507,21 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			EnableAuthenticationUsingADOPipelineOIDC:   enableOidc,
 		}
 
-		return buildClient(ctx, p, d, authConfig)
+		// recording is intentionally not exposed in the provider block, since it's only intended for testing
+		clientRecorder := recorder
+		if clientRecorder == nil {
+			if clientRecorder, err = common.NewRecorderFromEnvironment(); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		return buildClient(ctx, p, d, authConfig, clientRecorder)
 	}
 }
 
 // buildClient is used to configure behavioral aspects of the provider. To configure the
 // cloud environment and authentication-related settings, use the providerConfigure function.
-func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
+func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, recorder *common.Recorder) (*clients.Client, diag.Diagnostics) {
 	providerRegistrations := d.Get("resource_provider_registrations").(string)
 
 	// TODO: Remove in v5.0
@@ -529,7 +550,9 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		Recorder:                    recorder,
 		RegisteredResourceProviders: requiredResourceProviders,
+		Retry:                       expandRetry(d.Get("retry").([]interface{})),
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// registerSensitiveAttributes registers the names of any attributes marked as Sensitive, so that API properties
+// with the same name are redacted when logging requests and responses
+func registerSensitiveAttributes(input map[string]*schema.Resource) {
+	names := make(map[string]struct{})
+	for _, r := range input {
+		sensitiveAttributeNames(r.Schema, names)
+	}
+
+	output := make([]string, 0, len(names))
+	for name := range names {
+		output = append(output, name)
+	}
+	common.RegisterSensitiveProperties(output...)
+}
+
+func sensitiveAttributeNames(input map[string]*schema.Schema, names map[string]struct{}) {
+	for name, v := range input {
+		if v == nil {
+			continue
+		}
+
+		if v.Sensitive {
+			names[name] = struct{}{}
+		}
+
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			sensitiveAttributeNames(elem.Schema, names)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func schemaRetry() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configures how requests to Resource Manager are retried when throttled or failing, and how quickly requests are sent to each Subscription.",
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of attempts made to send a request which is throttled or fails with a transient error, including the first attempt. Requests sent by `go-azure-sdk` clients use the SDK's own retry policy.",
+				},
+
+				"max_backoff_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of seconds to wait between two attempts, including when Resource Manager returns a longer `Retry-After`.",
+				},
+
+				"requests_per_second": {
+					Type:         pluginsdk.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "The number of requests per second which can be sent to each Subscription. Defaults to `0`, meaning requests are not rate limited.",
+				},
+
+				"burst": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of requests which can be sent to each Subscription at once, before `requests_per_second` applies.",
+				},
+			},
+		},
+	}
+}
+
+func expandRetry(input []interface{}) *common.RetryOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	return &common.RetryOptions{
+		MaxAttempts:       raw["max_attempts"].(int),
+		MaxBackoff:        time.Duration(raw["max_backoff_in_seconds"].(int)) * time.Second,
+		RequestsPerSecond: raw["requests_per_second"].(float64),
+		Burst:             raw["burst"].(int),
+	}
+}
@@ -346,8 +346,11 @@ func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
 		// Services with Framework Resources, Data Sources, or Ephemeral Resources to be listed here
 		// e.g.
 		// resource.Registration{}
+		authorization.Registration{},
+		azurestackhci.Registration{},
 		compute.Registration{},
 		keyvault.Registration{},
+		mysql.Registration{},
 		network.Registration{},
 		storage.Registration{},
 	}
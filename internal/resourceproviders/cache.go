@@ -6,8 +6,11 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
@@ -37,6 +40,7 @@ func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersCli
 	return nil
 }
 
+// ClearCache clears the in-memory cache, the on-disk cache (when enabled) is left as-is
 func ClearCache() {
 	cacheLock.Lock()
 	cachedResourceProviders = nil
@@ -49,28 +53,112 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
+	diskCache := diskCacheFromEnvironment()
+	if diskCache != nil {
+		if entry := diskCache.read(client.Client.BaseUri, subscriptionId.SubscriptionId); entry != nil {
+			log.Printf("[DEBUG] Using the Resource Providers for %s cached at %s", subscriptionId, entry.RetrievedAt)
+			loadCache(entry.Registered, entry.Unregistered)
+			return nil
+		}
+	}
+
 	providers, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
 	if err != nil {
 		return fmt.Errorf("listing Resource Providers: %+v", err)
 	}
 
-	providerNames := make([]string, 0)
-	registeredResourceProviders = make(map[string]struct{})
-	unregisteredResourceProviders = make(map[string]struct{})
+	registered := make([]string, 0)
+	unregistered := make([]string, 0)
 	for _, provider := range providers.Items {
 		if provider.Namespace == nil {
 			continue
 		}
 
-		providerNames = append(providerNames, *provider.Namespace)
-		registered := provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered")
-		if registered {
-			registeredResourceProviders[*provider.Namespace] = struct{}{}
+		if provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered") {
+			registered = append(registered, *provider.Namespace)
 		} else {
-			unregisteredResourceProviders[*provider.Namespace] = struct{}{}
+			unregistered = append(unregistered, *provider.Namespace)
+		}
+	}
+
+	loadCache(registered, unregistered)
+
+	if diskCache != nil {
+		entry := diskCacheEntry{
+			Endpoint:       client.Client.BaseUri,
+			SubscriptionId: subscriptionId.SubscriptionId,
+			RetrievedAt:    time.Now(),
+			Registered:     registered,
+			Unregistered:   unregistered,
+		}
+		if err := diskCache.write(entry); err != nil {
+			log.Printf("[WARN] Writing the Resource Provider cache: %+v", err)
 		}
 	}
 
-	cachedResourceProviders = &providerNames
 	return nil
 }
+
+// loadCache populates the in-memory cache from the registered and unregistered Resource Providers - the caller
+// must hold the lock
+func loadCache(registered, unregistered []string) {
+	providerNames := make([]string, 0, len(registered)+len(unregistered))
+	registeredResourceProviders = make(map[string]struct{})
+	unregisteredResourceProviders = make(map[string]struct{})
+
+	for _, provider := range registered {
+		providerNames = append(providerNames, provider)
+		registeredResourceProviders[provider] = struct{}{}
+	}
+	for _, provider := range unregistered {
+		providerNames = append(providerNames, provider)
+		unregisteredResourceProviders[provider] = struct{}{}
+	}
+
+	sort.Strings(providerNames)
+	cachedResourceProviders = &providerNames
+}
+
+// markRegistered records that the Resource Providers have been registered, both in memory and (when enabled) in
+// the on-disk cache, so that subsequent runs don't attempt to register them again
+func markRegistered(client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, providerNames []string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	for _, provider := range providerNames {
+		registeredResourceProviders[provider] = struct{}{}
+		delete(unregisteredResourceProviders, provider)
+	}
+
+	diskCache := diskCacheFromEnvironment()
+	if diskCache == nil {
+		return
+	}
+
+	// the entry retains the time it was retrieved, so that it still expires as expected
+	entry := diskCache.read(client.Client.BaseUri, subscriptionId.SubscriptionId)
+	if entry == nil {
+		return
+	}
+
+	entry.Registered = make([]string, 0, len(registeredResourceProviders))
+	for provider := range registeredResourceProviders {
+		entry.Registered = append(entry.Registered, provider)
+	}
+	entry.Unregistered = make([]string, 0, len(unregisteredResourceProviders))
+	for provider := range unregisteredResourceProviders {
+		entry.Unregistered = append(entry.Unregistered, provider)
+	}
+
+	if err := diskCache.write(*entry); err != nil {
+		log.Printf("[WARN] Writing the Resource Provider cache: %+v", err)
+	}
+}
+
+// invalidateDiskCache removes the on-disk cache for the Subscription (when enabled), so that the registration
+// state is retrieved from the API on the next run
+func invalidateDiskCache(client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) {
+	if diskCache := diskCacheFromEnvironment(); diskCache != nil {
+		diskCache.remove(client.Client.BaseUri, subscriptionId.SubscriptionId)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// diskCacheDirectoryEnvVar enables the on-disk cache of Resource Provider registration state, which allows
+	// the state to be reused across Terraform runs
+	diskCacheDirectoryEnvVar = "ARM_RESOURCE_PROVIDER_CACHE_DIRECTORY"
+
+	// diskCacheTTLEnvVar specifies how long an on-disk cache entry is valid for, as a duration (e.g. `30m`)
+	diskCacheTTLEnvVar = "ARM_RESOURCE_PROVIDER_CACHE_TTL"
+
+	defaultDiskCacheTTL = time.Hour
+)
+
+// diskCacheEntry is the Resource Provider registration state for a single Subscription within an Environment
+type diskCacheEntry struct {
+	Endpoint       string    `json:"endpoint"`
+	SubscriptionId string    `json:"subscriptionId"`
+	RetrievedAt    time.Time `json:"retrievedAt"`
+	Registered     []string  `json:"registered"`
+	Unregistered   []string  `json:"unregistered"`
+}
+
+type diskCache struct {
+	directory string
+	ttl       time.Duration
+}
+
+// diskCacheFromEnvironment returns the on-disk cache when `ARM_RESOURCE_PROVIDER_CACHE_DIRECTORY` is set
+func diskCacheFromEnvironment() *diskCache {
+	directory := os.Getenv(diskCacheDirectoryEnvVar)
+	if directory == "" {
+		return nil
+	}
+
+	ttl := defaultDiskCacheTTL
+	if v := os.Getenv(diskCacheTTLEnvVar); v != "" {
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			log.Printf("[WARN] Ignoring the invalid value %q for %s, using the default of %s", v, diskCacheTTLEnvVar, defaultDiskCacheTTL)
+		} else {
+			ttl = parsed
+		}
+	}
+
+	return &diskCache{
+		directory: directory,
+		ttl:       ttl,
+	}
+}
+
+// path returns the file for the Subscription within the Environment - the Resource Manager endpoint identifies
+// the Environment, since custom Environments can share a name
+func (c diskCache) path(endpoint, subscriptionId string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(fmt.Sprintf("%s|%s", strings.TrimSuffix(endpoint, "/"), subscriptionId))))
+	return filepath.Join(c.directory, fmt.Sprintf("resource-providers-%s.json", hex.EncodeToString(hash[:8])))
+}
+
+// read returns the cached entry, or nil when there's no entry or the entry has expired
+func (c diskCache) read(endpoint, subscriptionId string) *diskCacheEntry {
+	path := c.path(endpoint, subscriptionId)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] Reading the Resource Provider cache from %q: %+v", path, err)
+		}
+		return nil
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(contents, &entry); err != nil {
+		log.Printf("[DEBUG] Ignoring the invalid Resource Provider cache at %q: %+v", path, err)
+		return nil
+	}
+
+	// guard against hash collisions and hand-edited files
+	if !strings.EqualFold(entry.SubscriptionId, subscriptionId) || !strings.EqualFold(strings.TrimSuffix(entry.Endpoint, "/"), strings.TrimSuffix(endpoint, "/")) {
+		return nil
+	}
+
+	if age := time.Since(entry.RetrievedAt); age < 0 || age > c.ttl {
+		log.Printf("[DEBUG] The Resource Provider cache at %q has expired", path)
+		return nil
+	}
+
+	return &entry
+}
+
+// write saves the entry, via a temporary file so that concurrent Terraform runs never read a partial entry
+func (c diskCache) write(entry diskCacheEntry) error {
+	if err := os.MkdirAll(c.directory, 0o700); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", c.directory, err)
+	}
+
+	sort.Strings(entry.Registered)
+	sort.Strings(entry.Unregistered)
+
+	contents, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling: %+v", err)
+	}
+
+	path := c.path(entry.Endpoint, entry.SubscriptionId)
+	tmp, err := os.CreateTemp(c.directory, filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %+v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing %q: %+v", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing %q: %+v", tmp.Name(), err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("renaming %q to %q: %+v", tmp.Name(), path, err)
+	}
+
+	return nil
+}
+
+// remove deletes the cached entry, so that the registration state is retrieved from the API on the next run
+func (c diskCache) remove(endpoint, subscriptionId string) {
+	path := c.path(endpoint, subscriptionId)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("[DEBUG] Removing the Resource Provider cache at %q: %+v", path, err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiskCache(t *testing.T) {
+	cache := diskCache{
+		directory: t.TempDir(),
+		ttl:       time.Hour,
+	}
+
+	endpoint := "https://management.azure.com/"
+	subscriptionId := "11111111-2222-3333-4444-555555555555"
+
+	if entry := cache.read(endpoint, subscriptionId); entry != nil {
+		t.Fatalf("expected no entry before the cache is written")
+	}
+
+	err := cache.write(diskCacheEntry{
+		Endpoint:       endpoint,
+		SubscriptionId: subscriptionId,
+		RetrievedAt:    time.Now(),
+		Registered:     []string{"Microsoft.Storage", "Microsoft.Compute"},
+		Unregistered:   []string{"Microsoft.Web"},
+	})
+	if err != nil {
+		t.Fatalf("writing cache: %+v", err)
+	}
+
+	entry := cache.read("https://MANAGEMENT.azure.com", subscriptionId)
+	if entry == nil {
+		t.Fatalf("expected an entry for the same endpoint and subscription")
+	}
+	if len(entry.Registered) != 2 || entry.Registered[0] != "Microsoft.Compute" {
+		t.Fatalf("expected the registered Resource Providers to be sorted but got %+v", entry.Registered)
+	}
+
+	if entry := cache.read("https://management.chinacloudapi.cn/", subscriptionId); entry != nil {
+		t.Fatalf("expected no entry for a different environment")
+	}
+	if entry := cache.read(endpoint, "11111111-2222-3333-4444-000000000000"); entry != nil {
+		t.Fatalf("expected no entry for a different subscription")
+	}
+
+	cache.remove(endpoint, subscriptionId)
+	if entry := cache.read(endpoint, subscriptionId); entry != nil {
+		t.Fatalf("expected no entry once the cache is removed")
+	}
+}
+
+func TestDiskCacheExpiry(t *testing.T) {
+	cache := diskCache{
+		directory: t.TempDir(),
+		ttl:       time.Minute,
+	}
+
+	err := cache.write(diskCacheEntry{
+		Endpoint:       "https://management.azure.com/",
+		SubscriptionId: "11111111-2222-3333-4444-555555555555",
+		RetrievedAt:    time.Now().Add(-2 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("writing cache: %+v", err)
+	}
+
+	if entry := cache.read("https://management.azure.com/", "11111111-2222-3333-4444-555555555555"); entry != nil {
+		t.Fatalf("expected an expired entry not to be returned")
+	}
+}
+
+func TestDiskCacheFromEnvironment(t *testing.T) {
+	t.Setenv(diskCacheDirectoryEnvVar, "")
+	if cache := diskCacheFromEnvironment(); cache != nil {
+		t.Fatalf("expected no cache when %s is unset", diskCacheDirectoryEnvVar)
+	}
+
+	t.Setenv(diskCacheDirectoryEnvVar, t.TempDir())
+	t.Setenv(diskCacheTTLEnvVar, "15m")
+	cache := diskCacheFromEnvironment()
+	if cache == nil || cache.ttl != 15*time.Minute {
+		t.Fatalf("expected a cache with a TTL of 15m but got %+v", cache)
+	}
+
+	t.Setenv(diskCacheTTLEnvVar, "invalid")
+	if cache := diskCacheFromEnvironment(); cache == nil || cache.ttl != defaultDiskCacheTTL {
+		t.Fatalf("expected the default TTL for an invalid value but got %+v", cache)
+	}
+}
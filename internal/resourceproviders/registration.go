@@ -48,9 +48,12 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 
 	log.Printf("[DEBUG] Registering %d Resource Providers", len(*providersToRegister))
 	if err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister); err != nil {
+		invalidateDiskCache(client, subscriptionId)
 		return userError(err)
 	}
 
+	markRegistered(client, subscriptionId, *providersToRegister)
+
 	return nil
 }
 
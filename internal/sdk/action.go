@@ -5,9 +5,21 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -39,3 +51,177 @@ func (a *ActionMetadata) Defaults(_ context.Context, request action.ConfigureReq
 	a.SubscriptionId = c.Account.SubscriptionId
 	a.Features = c.Features
 }
+
+// ActionTimeoutsModel is the model for the `timeouts` block returned from ActionTimeoutsBlock
+type ActionTimeoutsModel struct {
+	Invoke types.String `tfsdk:"invoke"`
+}
+
+// ActionTimeoutsBlock returns the `timeouts` block for an Action, allowing users to configure how long the Action
+// can take to complete
+func ActionTimeoutsBlock() schema.Block {
+	return schema.SingleNestedBlock{
+		Attributes: map[string]schema.Attribute{
+			"invoke": schema.StringAttribute{
+				Optional:            true,
+				Description:         `A string that can be parsed as a duration consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are "s" (seconds), "m" (minutes), "h" (hours).`,
+				MarkdownDescription: `A string that can be parsed as a duration consisting of numbers and unit suffixes, such as "30s" or "2h45m". Valid time units are "s" (seconds), "m" (minutes), "h" (hours).`,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validateActionTimeout,
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateActionTimeout(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be a positive duration such as `30m` or `2h`, got %q", key, v))
+	}
+
+	return
+}
+
+// InvokeTimeout returns the duration configured in the `timeouts` block of an Action, or defaultTimeout when it's
+// not configured
+func (a *ActionMetadata) InvokeTimeout(ctx context.Context, timeouts types.Object, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return defaultTimeout, nil
+	}
+
+	model := ActionTimeoutsModel{}
+	if diags := timeouts.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return 0, diags
+	}
+
+	if model.Invoke.IsNull() || model.Invoke.IsUnknown() || model.Invoke.ValueString() == "" {
+		return defaultTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(model.Invoke.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("parsing `timeouts.invoke`", err.Error())
+		return 0, diags
+	}
+
+	return timeout, nil
+}
+
+// actionProgressInterval is how often progress is reported whilst waiting for a long-running operation
+var actionProgressInterval = time.Minute
+
+// PollWithProgress waits for the long-running operation tracked by poller to complete, periodically reporting
+// progress to Terraform, and reporting the final status of the operation once it's completed. The context must
+// have a deadline, typically configured via InvokeTimeout.
+func (a *ActionMetadata) PollWithProgress(ctx context.Context, response *action.InvokeResponse, description string, poller pollers.Poller) error {
+	start := time.Now()
+
+	// the poller can't be read whilst it's polling, so the progress is captured from each polling response instead
+	progress := &actionOperationProgress{}
+	ctx = common.WithOperationProgress(ctx, progress.set)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.PollUntilDone(ctx)
+	}()
+
+	ticker := time.NewTicker(actionProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			message := fmt.Sprintf("%s: still in progress (%s elapsed)", description, time.Since(start).Round(time.Second))
+			if latest, ok := progress.get(); ok {
+				message = fmt.Sprintf("%s: %s (%s elapsed)", description, latest, time.Since(start).Round(time.Second))
+			}
+			if deadline, ok := ctx.Deadline(); ok {
+				message = fmt.Sprintf("%s, timing out in %s", message, time.Until(deadline).Round(time.Second))
+			}
+			sendActionProgress(response, message)
+
+		case err := <-done:
+			elapsed := time.Since(start).Round(time.Second)
+			if err != nil {
+				return fmt.Errorf("%s: operation finished with status %q after %s: %+v", description, actionOperationStatus(poller, err), elapsed, err)
+			}
+
+			sendActionProgress(response, fmt.Sprintf("%s: operation finished with status %q after %s", description, actionOperationStatus(poller, nil), elapsed))
+			return nil
+		}
+	}
+}
+
+// actionOperationProgress holds the latest progress of a long-running operation, which is set whilst polling
+type actionOperationProgress struct {
+	mu     sync.Mutex
+	latest *common.OperationProgress
+}
+
+func (p *actionOperationProgress) set(progress common.OperationProgress) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.latest = &progress
+}
+
+// get returns a description of the latest progress, if any has been returned whilst polling
+func (p *actionOperationProgress) get() (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.latest == nil {
+		return "", false
+	}
+
+	status := p.latest.Status
+	if status == "" {
+		status = string(pollers.PollingStatusInProgress)
+	}
+	if p.latest.PercentComplete != nil {
+		return fmt.Sprintf("status %q, %.0f%% complete", status, *p.latest.PercentComplete), true
+	}
+
+	return fmt.Sprintf("status %q", status), true
+}
+
+// actionOperationStatus returns the final status of the long-running operation - it's only safe to call once
+// polling has completed
+func actionOperationStatus(poller pollers.Poller, err error) pollers.PollingStatus {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		// the operation is still running in Azure
+		return pollers.PollingStatusInProgress
+	case err != nil:
+		var failed pollers.PollingFailedError
+		if errors.As(err, &failed) {
+			return pollers.PollingStatusFailed
+		}
+		var cancelled pollers.PollingCancelledError
+		if errors.As(err, &cancelled) {
+			return pollers.PollingStatusCancelled
+		}
+		return pollers.PollingStatusUnknown
+	}
+
+	return poller.LatestStatus()
+}
+
+func sendActionProgress(response *action.InvokeResponse, message string) {
+	if response.SendProgress == nil {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: message,
+	})
+}
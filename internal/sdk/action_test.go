@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type testActionPoller struct {
+	polls   int
+	results []pollers.PollingStatus
+}
+
+func (p *testActionPoller) Poll(_ context.Context) (*pollers.PollResult, error) {
+	status := p.results[p.polls]
+	p.polls++
+
+	if status == pollers.PollingStatusFailed {
+		return nil, pollers.PollingFailedError{
+			Message: "the operation failed",
+		}
+	}
+
+	return &pollers.PollResult{
+		PollInterval: 10 * time.Millisecond,
+		Status:       status,
+	}, nil
+}
+
+// testActionOperationPoller polls an operation status endpoint until it's succeeded
+type testActionOperationPoller struct {
+	client *client.Client
+}
+
+func (p *testActionOperationPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	req, err := p.client.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/operations/example",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Execute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var model struct {
+		Status pollers.PollingStatus `json:"status"`
+	}
+	if err := resp.Unmarshal(&model); err != nil {
+		return nil, err
+	}
+
+	return &pollers.PollResult{
+		HttpResponse: resp,
+		PollInterval: 10 * time.Millisecond,
+		Status:       model.Status,
+	}, nil
+}
+
+func TestActionMetadataInvokeTimeout(t *testing.T) {
+	ctx := context.Background()
+	a := ActionMetadata{}
+	attributeTypes := map[string]attr.Type{
+		"invoke": types.StringType,
+	}
+
+	timeout, diags := a.InvokeTimeout(ctx, types.ObjectNull(attributeTypes), time.Minute)
+	if diags.HasError() || timeout != time.Minute {
+		t.Fatalf("expected the default timeout when the block is omitted but got %s (%+v)", timeout, diags)
+	}
+
+	timeouts := types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"invoke": types.StringValue("2h"),
+	})
+	timeout, diags = a.InvokeTimeout(ctx, timeouts, time.Minute)
+	if diags.HasError() || timeout != 2*time.Hour {
+		t.Fatalf("expected the configured timeout but got %s (%+v)", timeout, diags)
+	}
+}
+
+func TestActionMetadataPollWithProgress(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	actionProgressInterval = 5 * time.Millisecond
+
+	messages := make([]string, 0)
+	response := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+
+	a := ActionMetadata{}
+	poller := pollers.NewPoller(&testActionPoller{
+		results: []pollers.PollingStatus{
+			pollers.PollingStatusInProgress,
+			pollers.PollingStatusInProgress,
+			pollers.PollingStatusSucceeded,
+		},
+	}, 10*time.Millisecond, 1)
+
+	if err := a.PollWithProgress(ctx, response, "example", poller); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if len(messages) < 2 {
+		t.Fatalf("expected progress to be reported whilst polling but got %+v", messages)
+	}
+	if last := messages[len(messages)-1]; !strings.Contains(last, `status "Succeeded"`) {
+		t.Fatalf("expected the final status to be reported but got %q", last)
+	}
+}
+
+func TestActionMetadataPollWithProgressFailed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	a := ActionMetadata{}
+	poller := pollers.NewPoller(&testActionPoller{
+		results: []pollers.PollingStatus{
+			pollers.PollingStatusFailed,
+		},
+	}, 10*time.Millisecond, 1)
+
+	err := a.PollWithProgress(ctx, &action.InvokeResponse{}, "example", poller)
+	if err == nil || !strings.Contains(err.Error(), `status "Failed"`) {
+		t.Fatalf("expected the failed status to be returned but got %+v", err)
+	}
+}
+
+func TestActionMetadataPollWithProgressReportsOperationStatus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	actionProgressInterval = 5 * time.Millisecond
+
+	var polls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if polls < 5 {
+			_, _ = w.Write([]byte(`{"status": "InProgress", "percentComplete": 40}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "Succeeded", "percentComplete": 100}`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "test", "2020-01-01")
+	common.ClientOptions{}.Configure(c, nil)
+
+	var mu sync.Mutex
+	messages := make([]string, 0)
+	response := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			messages = append(messages, event.Message)
+		},
+	}
+
+	a := ActionMetadata{}
+	poller := pollers.NewPoller(&testActionOperationPoller{
+		client: c,
+	}, 10*time.Millisecond, 1)
+
+	if err := a.PollWithProgress(ctx, response, "example", poller); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, message := range messages {
+		if strings.Contains(message, `status "InProgress", 40% complete`) {
+			return
+		}
+	}
+	t.Fatalf("expected the operation status to be reported whilst polling but got %+v", messages)
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	resourceGroupsSdk "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ResourceWithListKindFilter is an optional interface for Resources whose Resource Manager type is shared with other
+// Terraform Resources (for example App Service and Function Apps), allowing the GenericListResource to return only
+// those resources with a matching `kind`
+type ResourceWithListKindFilter interface {
+	ResourceWithIdentity
+
+	// ListKindFilter returns whether a resource of the specified kind is managed by this Resource
+	ListKindFilter(kind string) bool
+}
+
+var _ ListResourceWithRawV5Schemas = &GenericListResource{}
+
+// GenericListResource is a List Resource for a typed Resource implementing ResourceWithIdentity whose Resource ID is
+// scoped directly to a Subscription or a Resource Group. Resources are listed using the Resource Manager Resources API
+// and then populated using the Resource's Read function.
+type GenericListResource struct {
+	ListResourceMetadata
+
+	resource ResourceWithIdentity
+	scope    genericListScope
+}
+
+// genericListScope is the Resource Manager type and parent scope, derived from the Resource's Identity
+type genericListScope struct {
+	resourceType       string
+	resourceGroupScope bool
+}
+
+// NewGenericListResource returns a GenericListResource for the Resource when supported, that is when the Resource
+// implements ResourceWithIdentity and its Resource ID is a top-level resource within a Subscription or Resource Group
+func NewGenericListResource(r Resource) (func() list.ListResource, bool) {
+	v, ok := r.(ResourceWithIdentity)
+	if !ok {
+		return nil, false
+	}
+
+	// virtual resources (e.g. Storage Account properties) share their Resource ID with another Resource
+	if o, ok := r.(ResourceWithIdentityTypeOverride); ok && o.IdentityType() == pluginsdk.ResourceTypeForIdentityVirtual {
+		return nil, false
+	}
+
+	scope, ok := genericListScopeForResourceId(v.Identity())
+	if !ok {
+		return nil, false
+	}
+
+	return func() list.ListResource {
+		return &GenericListResource{
+			resource: v,
+			scope:    scope,
+		}
+	}, true
+}
+
+// genericListScopeForResourceId returns the Resource Manager type for Resource IDs in the format
+// `/subscriptions/{subscriptionId}[/resourceGroups/{resourceGroupName}]/providers/{namespace}/{type}/{name}`
+func genericListScopeForResourceId(id resourceids.ResourceId) (genericListScope, bool) {
+	segments := id.Segments()
+
+	expected := []resourceids.SegmentType{
+		resourceids.StaticSegmentType,
+		resourceids.SubscriptionIdSegmentType,
+	}
+	resourceGroupScope := len(segments) == 8
+	if resourceGroupScope {
+		expected = append(expected, resourceids.StaticSegmentType, resourceids.ResourceGroupSegmentType)
+	}
+	expected = append(expected,
+		resourceids.StaticSegmentType,
+		resourceids.ResourceProviderSegmentType,
+		resourceids.StaticSegmentType,
+		resourceids.UserSpecifiedSegmentType,
+	)
+
+	if len(segments) != len(expected) {
+		return genericListScope{}, false
+	}
+	for i, segment := range segments {
+		if segment.Type != expected[i] {
+			return genericListScope{}, false
+		}
+	}
+
+	namespace := segments[len(segments)-3].FixedValue
+	resourceType := segments[len(segments)-2].FixedValue
+	if namespace == nil || resourceType == nil {
+		return genericListScope{}, false
+	}
+
+	return genericListScope{
+		resourceType:       fmt.Sprintf("%s/%s", *namespace, *resourceType),
+		resourceGroupScope: resourceGroupScope,
+	}, true
+}
+
+func (r *GenericListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = r.resource.ResourceType()
+}
+
+func (r *GenericListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	// any error building the schema is surfaced when the Resource itself is registered, so can be ignored here
+	res, err := r.pluginSdkResource()
+	if err != nil {
+		return
+	}
+
+	response.ProtoV5Schema = res.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *GenericListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	// both are optional, defaulting to listing all resources in the Provider's Subscription - `resource_group_name`
+	// is only supported for resources within a Resource Group
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"resource_group_name": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: resourcegroups.ValidateName,
+					},
+				},
+			},
+			"subscription_id": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+
+	if !r.scope.resourceGroupScope {
+		delete(response.Schema.Attributes, "resource_group_name")
+	}
+}
+
+func (r *GenericListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+
+	// `resource_group_name` is only present for resources within a Resource Group, so the attributes are retrieved individually
+	var subscriptionIdValue, resourceGroupNameValue types.String
+	diags := request.Config.GetAttribute(ctx, path.Root("subscription_id"), &subscriptionIdValue)
+	if r.scope.resourceGroupScope {
+		diags.Append(request.Config.GetAttribute(ctx, path.Root("resource_group_name"), &resourceGroupNameValue)...)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	subscriptionId := r.SubscriptionId
+	if subscriptionIdValue.ValueString() != "" {
+		subscriptionId = subscriptionIdValue.ValueString()
+	}
+
+	filter := fmt.Sprintf("resourceType eq '%s'", r.scope.resourceType)
+	listResults := make([]genericListItem, 0)
+
+	switch {
+	case resourceGroupNameValue.ValueString() != "":
+		resourceGroupId := commonids.NewResourceGroupID(subscriptionId, resourceGroupNameValue.ValueString())
+		resp, err := r.Client.Resource.ResourceGroupsClient.ResourcesListByResourceGroupComplete(ctx, resourceGroupId, resourceGroupsSdk.ResourcesListByResourceGroupOperationOptions{
+			Filter: pointer.To(filter),
+		})
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", r.resource.ResourceType()), err)
+			return
+		}
+
+		for _, item := range resp.Items {
+			listResults = append(listResults, genericListItem{
+				id:   pointer.From(item.Id),
+				name: pointer.From(item.Name),
+				kind: pointer.From(item.Kind),
+			})
+		}
+
+	default:
+		resp, err := r.Client.Resource.ResourcesClient.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), resources.ListOperationOptions{
+			Filter: pointer.To(filter),
+		})
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", r.resource.ResourceType()), err)
+			return
+		}
+
+		for _, item := range resp.Items {
+			listResults = append(listResults, genericListItem{
+				id:   pointer.From(item.Id),
+				name: pointer.From(item.Name),
+				kind: pointer.From(item.Kind),
+			})
+		}
+	}
+
+	if v, ok := r.resource.(ResourceWithListKindFilter); ok {
+		filtered := make([]genericListItem, 0)
+		for _, item := range listResults {
+			if v.ListKindFilter(item.kind) {
+				filtered = append(filtered, item)
+			}
+		}
+		listResults = filtered
+	}
+
+	res, err := r.pluginSdkResource()
+	if err != nil {
+		SetResponseErrorDiagnostic(stream, "building schema", err)
+		return
+	}
+
+	var idType pluginsdk.ResourceTypeForIdentity = pluginsdk.ResourceTypeForIdentityDefault
+	if v, ok := r.resource.(ResourceWithIdentityTypeOverride); ok {
+		idType = v.IdentityType()
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range listResults {
+			result := request.NewListResult(ctx)
+			result.DisplayName = item.name
+
+			id, err := r.parseResourceId(item.id)
+			if err != nil {
+				SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing %s ID", r.resource.ResourceType()), err)
+				return
+			}
+
+			rd := res.Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := r.read(ctx, rd); err != nil {
+				// best effort, other resources may still be readable
+				SetResponseWarningDiagnostic(stream, fmt.Sprintf("reading %s", id), err)
+				continue
+			}
+
+			// the resource was removed between listing and reading it
+			if rd.Id() == "" {
+				continue
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(rd, id, idType); err != nil {
+				SetResponseErrorDiagnostic(stream, "setting identity data", err)
+				return
+			}
+
+			tfTypeIdentity, err := rd.TfTypeIdentityState()
+			if err != nil {
+				SetResponseErrorDiagnostic(stream, "converting Identity State", err)
+				return
+			}
+
+			if err := result.Identity.Set(ctx, *tfTypeIdentity); err != nil {
+				SetResponseErrorDiagnostic(stream, "setting identity data", err)
+				return
+			}
+
+			tfTypeResource, err := rd.TfTypeResourceState()
+			if err != nil {
+				SetResponseErrorDiagnostic(stream, "converting Resource State data", err)
+				return
+			}
+
+			if err := result.Resource.Set(ctx, *tfTypeResource); err != nil {
+				SetResponseErrorDiagnostic(stream, "setting resource data", err)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (r *GenericListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
+
+type genericListItem struct {
+	id   string
+	name string
+	kind string
+}
+
+func (r *GenericListResource) pluginSdkResource() (*schema.Resource, error) {
+	wrapper := NewResourceWrapper(r.resource)
+	return wrapper.Resource()
+}
+
+// parseResourceId parses the Resource ID returned from the Resources API into the Resource's Identity type, since
+// the casing of the Resource ID returned from this API can differ from the Resource's own API
+func (r *GenericListResource) parseResourceId(input string) (resourceids.ResourceId, error) {
+	id := r.resource.Identity()
+
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(input, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// read populates the ResourceData using the Resource's Read function
+func (r *GenericListResource) read(ctx context.Context, rd *schema.ResourceData) error {
+	ctx, cancel := context.WithTimeout(ctx, r.resource.Read().Timeout)
+	defer cancel()
+
+	return r.resource.Read().Func(ctx, runArgs(rd, r.Client, &DiagnosticsLogger{}))
+}
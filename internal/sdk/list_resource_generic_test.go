@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"golang.org/x/oauth2"
+)
+
+func TestGenericListScopeForResourceId(t *testing.T) {
+	testData := []struct {
+		name               string
+		input              resourceids.ResourceId
+		supported          bool
+		resourceType       string
+		resourceGroupScope bool
+	}{
+		{
+			name:               "Resource Group scoped",
+			input:              &commonids.FunctionAppId{},
+			supported:          true,
+			resourceType:       "Microsoft.Web/sites",
+			resourceGroupScope: true,
+		},
+		{
+			name:  "Subscription",
+			input: &commonids.SubscriptionId{},
+		},
+		{
+			name:  "Resource Group",
+			input: &commonids.ResourceGroupId{},
+		},
+		{
+			name:  "Child Resource",
+			input: &commonids.SubnetId{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual, ok := genericListScopeForResourceId(v.input)
+			if ok != v.supported {
+				t.Fatalf("expected supported to be %t but got %t", v.supported, ok)
+			}
+			if actual.resourceType != v.resourceType {
+				t.Fatalf("expected resource type %q but got %q", v.resourceType, actual.resourceType)
+			}
+			if actual.resourceGroupScope != v.resourceGroupScope {
+				t.Fatalf("expected resource group scope to be %t but got %t", v.resourceGroupScope, actual.resourceGroupScope)
+			}
+		})
+	}
+}
+
+func TestGenericListResourceList(t *testing.T) {
+	subscriptionId := "11111111-2222-3333-4444-555555555555"
+	server := newGenericListTestServer(t, subscriptionId)
+
+	testData := []struct {
+		name              string
+		resource          ResourceWithIdentity
+		subscriptionId    string
+		resourceGroupName string
+		expectedPath      string
+		expected          []string
+	}{
+		{
+			// `gone` is listed but no longer exists when read, so is skipped
+			name:         "Subscription",
+			resource:     genericListTestResource{},
+			expectedPath: "/subscriptions/" + subscriptionId + "/resources",
+			expected:     []string{"function1", "web1"},
+		},
+		{
+			name:           "Other Subscription",
+			resource:       genericListTestResource{},
+			subscriptionId: "11111111-2222-3333-4444-666666666666",
+			expectedPath:   "/subscriptions/11111111-2222-3333-4444-666666666666/resources",
+			expected:       []string{"function1", "web1"},
+		},
+		{
+			name:              "Resource Group",
+			resource:          genericListTestResource{},
+			resourceGroupName: "group1",
+			expectedPath:      "/subscriptions/" + subscriptionId + "/resourceGroups/group1/resources",
+			expected:          []string{"function2"},
+		},
+		{
+			name:         "Kind Filter",
+			resource:     genericListTestResourceWithKindFilter{},
+			expectedPath: "/subscriptions/" + subscriptionId + "/resources",
+			expected:     []string{"function1"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			server.reset()
+
+			results, diags := listGenericTestResources(t, server, subscriptionId, v.resource, v.subscriptionId, v.resourceGroupName)
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %+v", diags)
+			}
+
+			requests := server.requests()
+			if len(requests) != 1 {
+				t.Fatalf("expected 1 request but got %d: %+v", len(requests), requests)
+			}
+			if !strings.EqualFold(requests[0].path, v.expectedPath) {
+				t.Fatalf("expected a request to %q but got %q", v.expectedPath, requests[0].path)
+			}
+			if expected := "resourceType eq 'Microsoft.Web/sites'"; requests[0].filter != expected {
+				t.Fatalf("expected the filter %q but got %q", expected, requests[0].filter)
+			}
+
+			actual := make([]string, 0)
+			for _, result := range results {
+				actual = append(actual, result.DisplayName)
+			}
+			sort.Strings(actual)
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		})
+	}
+}
+
+type genericListTestModel struct {
+	Name              string `tfschema:"name"`
+	ResourceGroupName string `tfschema:"resource_group_name"`
+}
+
+// genericListTestResource is a Resource for `Microsoft.Web/sites`, any resource named `gone` is treated as having
+// been deleted between listing and reading it
+type genericListTestResource struct{}
+
+var _ ResourceWithIdentity = genericListTestResource{}
+
+func (genericListTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+}
+
+func (genericListTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (genericListTestResource) ModelObject() interface{} {
+	return &genericListTestModel{}
+}
+
+func (genericListTestResource) ResourceType() string {
+	return "azurerm_generic_list_test"
+}
+
+func (genericListTestResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (genericListTestResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(_ context.Context, metadata ResourceMetaData) error {
+			id, err := commonids.ParseFunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if id.SiteName == "gone" {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&genericListTestModel{
+				Name:              id.SiteName,
+				ResourceGroupName: id.ResourceGroupName,
+			})
+		},
+	}
+}
+
+func (genericListTestResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (genericListTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateFunctionAppID
+}
+
+func (genericListTestResource) Identity() resourceids.ResourceId {
+	return &commonids.FunctionAppId{}
+}
+
+type genericListTestResourceWithKindFilter struct {
+	genericListTestResource
+}
+
+var _ ResourceWithListKindFilter = genericListTestResourceWithKindFilter{}
+
+func (genericListTestResourceWithKindFilter) ListKindFilter(kind string) bool {
+	return strings.Contains(kind, "functionapp")
+}
+
+type genericListTestAuthorizer struct{}
+
+func (genericListTestAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "test",
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (genericListTestAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+type genericListTestRequest struct {
+	path   string
+	filter string
+}
+
+type genericListTestServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	received []genericListTestRequest
+}
+
+func newGenericListTestServer(t *testing.T, subscriptionId string) *genericListTestServer {
+	site := func(resourceGroupName, name, kind string) map[string]interface{} {
+		return map[string]interface{}{
+			// the Resources API can return Resource IDs with different casing
+			"id":   "/subscriptions/" + subscriptionId + "/resourcegroups/" + resourceGroupName + "/providers/Microsoft.Web/sites/" + name,
+			"name": name,
+			"kind": kind,
+			"type": "Microsoft.Web/sites",
+		}
+	}
+
+	s := &genericListTestServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.received = append(s.received, genericListTestRequest{
+			path:   r.URL.Path,
+			filter: r.URL.Query().Get("$filter"),
+		})
+		s.mu.Unlock()
+
+		items := []interface{}{
+			site("group1", "function2", "functionapp,linux"),
+		}
+		if !strings.Contains(strings.ToLower(r.URL.Path), "/resourcegroups/") {
+			items = []interface{}{
+				site("group1", "function1", "functionapp,linux"),
+				site("group2", "web1", "app"),
+				site("group2", "gone", "functionapp"),
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{"value": items}); err != nil {
+			t.Errorf("encoding response: %+v", err)
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *genericListTestServer) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.received = nil
+}
+
+func (s *genericListTestServer) requests() []genericListTestRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]genericListTestRequest{}, s.received...)
+}
+
+// listGenericTestResources runs the GenericListResource for r against the server, returning the results
+func listGenericTestResources(t *testing.T, server *genericListTestServer, defaultSubscriptionId string, r ResourceWithIdentity, subscriptionId, resourceGroupName string) ([]list.ListResult, []string) {
+	ctx := context.Background()
+
+	resourcesClient, err := resources.NewResourcesClientWithBaseURI(environments.ResourceManagerAPI(server.URL))
+	if err != nil {
+		t.Fatalf("building Resources client: %+v", err)
+	}
+	resourceGroupsClient, err := resourcegroups.NewResourceGroupsClientWithBaseURI(environments.ResourceManagerAPI(server.URL))
+	if err != nil {
+		t.Fatalf("building Resource Groups client: %+v", err)
+	}
+
+	f, ok := NewGenericListResource(r)
+	if !ok {
+		t.Fatalf("expected %s to support listing", r.ResourceType())
+	}
+	listResource := f().(*GenericListResource)
+	resourcesClient.Client.SetAuthorizer(genericListTestAuthorizer{})
+	resourceGroupsClient.Client.SetAuthorizer(genericListTestAuthorizer{})
+
+	listResource.Client = &clients.Client{
+		Resource: &resourceClient.Client{
+			ResourcesClient:      resourcesClient,
+			ResourceGroupsClient: resourceGroupsClient,
+		},
+	}
+	listResource.SubscriptionId = defaultSubscriptionId
+
+	schemaResponse := list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+
+	optionalString := func(v string) tftypes.Value {
+		if v == "" {
+			return tftypes.NewValue(tftypes.String, nil)
+		}
+		return tftypes.NewValue(tftypes.String, v)
+	}
+	config := tfsdk.Config{
+		Schema: schemaResponse.Schema,
+		Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"resource_group_name": optionalString(resourceGroupName),
+			"subscription_id":     optionalString(subscriptionId),
+		}),
+	}
+
+	timeout := resourceschema.StringAttribute{Optional: true}
+	stream := list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config: config,
+		// these match the schemas of the genericListTestResource
+		ResourceSchema: resourceschema.Schema{
+			Attributes: map[string]resourceschema.Attribute{
+				"id":                  resourceschema.StringAttribute{Computed: true},
+				"name":                resourceschema.StringAttribute{Required: true},
+				"resource_group_name": resourceschema.StringAttribute{Required: true},
+			},
+			Blocks: map[string]resourceschema.Block{
+				"timeouts": resourceschema.SingleNestedBlock{
+					Attributes: map[string]resourceschema.Attribute{
+						"create": timeout,
+						"read":   timeout,
+						"delete": timeout,
+					},
+				},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"subscription_id":     identityschema.StringAttribute{RequiredForImport: true},
+				"resource_group_name": identityschema.StringAttribute{RequiredForImport: true},
+				"name":                identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}, &stream)
+
+	results := make([]list.ListResult, 0)
+	diags := make([]string, 0)
+	for result := range stream.Results {
+		for _, d := range result.Diagnostics {
+			diags = append(diags, d.Summary()+": "+d.Detail())
+		}
+		if result.Diagnostics.HasError() {
+			continue
+		}
+		results = append(results, result)
+	}
+
+	return results, diags
+}
@@ -94,6 +94,7 @@ var (
 	_ sdk.ResourceWithCustomizeDiff  = LinuxFunctionAppResource{}
 	_ sdk.ResourceWithStateMigration = LinuxFunctionAppResource{}
 	_ sdk.ResourceWithIdentity       = LinuxFunctionAppResource{}
+	_ sdk.ResourceWithListKindFilter = LinuxFunctionAppResource{}
 )
 
 func (r LinuxFunctionAppResource) ModelObject() interface{} {
@@ -108,6 +109,12 @@ func (r LinuxFunctionAppResource) Identity() resourceids.ResourceId {
 	return &commonids.FunctionAppId{}
 }
 
+// ListKindFilter excludes Web Apps, Windows Function Apps and Logic Apps, which share the `Microsoft.Web/sites` type
+func (r LinuxFunctionAppResource) ListKindFilter(kind string) bool {
+	kind = strings.ToLower(kind)
+	return strings.Contains(kind, "functionapp") && strings.Contains(kind, "linux") && !strings.Contains(kind, "workflowapp")
+}
+
 func (r LinuxFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateFunctionAppID
 }
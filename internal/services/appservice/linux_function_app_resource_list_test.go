@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice"
+)
+
+func TestLinuxFunctionAppResource_ListKindFilter(t *testing.T) {
+	testData := []struct {
+		kind     string
+		expected bool
+	}{
+		{
+			kind:     "functionapp,linux",
+			expected: true,
+		},
+		{
+			kind:     "functionapp,linux,container",
+			expected: true,
+		},
+		{
+			kind:     "FunctionApp,Linux",
+			expected: true,
+		},
+		{
+			kind:     "functionapp,linux,workflowapp",
+			expected: false,
+		},
+		{
+			kind:     "functionapp",
+			expected: false,
+		},
+		{
+			kind:     "app,linux",
+			expected: false,
+		},
+		{
+			kind:     "app",
+			expected: false,
+		},
+		{
+			kind:     "",
+			expected: false,
+		},
+	}
+
+	r := appservice.LinuxFunctionAppResource{}
+	for _, v := range testData {
+		if actual := r.ListKindFilter(v.kind); actual != v.expected {
+			t.Fatalf("expected %t for kind %q but got %t", v.expected, v.kind, actual)
+		}
+	}
+}
+
+func TestAccLinuxFunctionApp_list_basic(t *testing.T) {
+	r := LinuxFunctionAppResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_linux_function_app", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data, SkuBasicPlan),
+			},
+			{
+				Query:             true,
+				Config:            r.basicQuery(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{}, // TODO
+			},
+			{
+				Query:             true,
+				Config:            r.basicQueryByResourceGroup(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{}, // TODO
+			},
+		},
+	})
+}
+
+func (r LinuxFunctionAppResource) basicQuery(_ acceptance.TestData) string {
+	return `
+list "azurerm_linux_function_app" "test" {
+  provider = azurerm
+  config {}
+}`
+}
+
+func (r LinuxFunctionAppResource) basicQueryByResourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_linux_function_app" "test" {
+  provider = azurerm
+  config {
+    resource_group_name = "acctestRG-LFA-%d"
+  }
+}
+`, data.RandomInteger)
+}
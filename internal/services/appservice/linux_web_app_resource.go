@@ -1,6 +1,8 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name linux_web_app -properties "name,resource_group_name" -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary"
+
 package appservice
 
 import (
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -78,6 +81,8 @@ var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithListKindFilter = LinuxWebAppResource{}
+
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -279,6 +284,16 @@ func (r LinuxWebAppResource) ResourceType() string {
 	return "azurerm_linux_web_app"
 }
 
+func (r LinuxWebAppResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
+// ListKindFilter excludes Windows Web Apps and Function Apps, which share the `Microsoft.Web/sites` type
+func (r LinuxWebAppResource) ListKindFilter(kind string) bool {
+	kind = strings.ToLower(kind)
+	return !strings.Contains(kind, "functionapp") && strings.Contains(kind, "linux")
+}
+
 func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -703,7 +718,7 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
@@ -1,6 +1,8 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name service_plan -properties "name,resource_group_name" -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary"
+
 package appservice
 
 import (
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/appserviceplans"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -31,6 +34,7 @@ var (
 	_ sdk.ResourceWithUpdate         = ServicePlanResource{}
 	_ sdk.ResourceWithStateMigration = ServicePlanResource{}
 	_ sdk.ResourceWithCustomizeDiff  = ServicePlanResource{}
+	_ sdk.ResourceWithIdentity       = ServicePlanResource{}
 )
 
 type OSType string
@@ -281,6 +285,10 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -307,6 +315,10 @@ func (r ServicePlanResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r ServicePlanResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServicePlanId{}
+}
+
 func (r ServicePlanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateAppServicePlanID
 }
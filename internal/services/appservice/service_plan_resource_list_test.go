@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccServicePlan_list_basic(t *testing.T) {
+	r := ServicePlanResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_service_plan", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+			},
+			{
+				Query:             true,
+				Config:            r.basicQuery(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{}, // TODO
+			},
+			{
+				Query:             true,
+				Config:            r.basicQueryByResourceGroup(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{}, // TODO
+			},
+		},
+	})
+}
+
+func (r ServicePlanResource) basicQuery(_ acceptance.TestData) string {
+	return `
+list "azurerm_service_plan" "test" {
+  provider = azurerm
+  config {}
+}`
+}
+
+func (r ServicePlanResource) basicQueryByResourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_service_plan" "test" {
+  provider = azurerm
+  config {
+    resource_group_name = "acctestRG-appserviceplan-%d"
+  }
+}
+`, data.RandomInteger)
+}
@@ -1,6 +1,8 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_function_app -properties "name,resource_group_name" -service-package-name appservice -test-params "B1" -known-values "subscription_id:data.Subscriptions.Primary"
+
 package appservice
 
 import (
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -91,6 +94,8 @@ var _ sdk.ResourceWithCustomizeDiff = WindowsFunctionAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsFunctionAppResource{}
 
+var _ sdk.ResourceWithListKindFilter = WindowsFunctionAppResource{}
+
 func (r WindowsFunctionAppResource) ModelObject() interface{} {
 	return &WindowsFunctionAppModel{}
 }
@@ -99,6 +104,16 @@ func (r WindowsFunctionAppResource) ResourceType() string {
 	return "azurerm_windows_function_app"
 }
 
+func (r WindowsFunctionAppResource) Identity() resourceids.ResourceId {
+	return &commonids.FunctionAppId{}
+}
+
+// ListKindFilter excludes Web Apps, Linux Function Apps and Logic Apps, which share the `Microsoft.Web/sites` type
+func (r WindowsFunctionAppResource) ListKindFilter(kind string) bool {
+	kind = strings.ToLower(kind)
+	return strings.Contains(kind, "functionapp") && !strings.Contains(kind, "linux") && !strings.Contains(kind, "workflowapp")
+}
+
 func (r WindowsFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateFunctionAppID
 }
@@ -851,7 +866,7 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
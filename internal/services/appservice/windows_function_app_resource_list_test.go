@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice"
+)
+
+func TestWindowsFunctionAppResource_ListKindFilter(t *testing.T) {
+	testData := []struct {
+		kind     string
+		expected bool
+	}{
+		{
+			kind:     "functionapp",
+			expected: true,
+		},
+		{
+			kind:     "FunctionApp",
+			expected: true,
+		},
+		{
+			kind:     "functionapp,linux",
+			expected: false,
+		},
+		{
+			kind:     "functionapp,workflowapp",
+			expected: false,
+		},
+		{
+			kind:     "app",
+			expected: false,
+		},
+		{
+			kind:     "app,linux",
+			expected: false,
+		},
+		{
+			kind:     "",
+			expected: false,
+		},
+	}
+
+	r := appservice.WindowsFunctionAppResource{}
+	for _, v := range testData {
+		if actual := r.ListKindFilter(v.kind); actual != v.expected {
+			t.Fatalf("expected %t for kind %q but got %t", v.expected, v.kind, actual)
+		}
+	}
+}
+
+func TestAccWindowsFunctionApp_list_basic(t *testing.T) {
+	r := WindowsFunctionAppResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_windows_function_app", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data, SkuBasicPlan),
+			},
+			{
+				Query:             true,
+				Config:            r.basicQuery(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{}, // TODO
+			},
+			{
+				Query:             true,
+				Config:            r.basicQueryByResourceGroup(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{}, // TODO
+			},
+		},
+	})
+}
+
+func (r WindowsFunctionAppResource) basicQuery(_ acceptance.TestData) string {
+	return `
+list "azurerm_windows_function_app" "test" {
+  provider = azurerm
+  config {}
+}`
+}
+
+func (r WindowsFunctionAppResource) basicQueryByResourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_windows_function_app" "test" {
+  provider = azurerm
+  config {
+    resource_group_name = "acctestRG-WFA-%d"
+  }
+}
+`, data.RandomInteger)
+}
@@ -1,6 +1,8 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_web_app -properties "name,resource_group_name" -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary"
+
 package appservice
 
 import (
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -73,6 +76,8 @@ var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithListKindFilter = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -268,6 +273,16 @@ func (r WindowsWebAppResource) ResourceType() string {
 	return "azurerm_windows_web_app"
 }
 
+func (r WindowsWebAppResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
+// ListKindFilter excludes Linux Web Apps and Function Apps, which share the `Microsoft.Web/sites` type
+func (r WindowsWebAppResource) ListKindFilter(kind string) bool {
+	kind = strings.ToLower(kind)
+	return !strings.Contains(kind, "functionapp") && !strings.Contains(kind, "linux")
+}
+
 func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -729,7 +744,7 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
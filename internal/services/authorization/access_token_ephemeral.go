@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"golang.org/x/oauth2"
+)
+
+var _ sdk.EphemeralResourceWithRenew = &AccessTokenEphemeralResource{}
+
+func NewAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AccessTokenEphemeralResource{}
+}
+
+type AccessTokenEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type AccessTokenEphemeralResourceModel struct {
+	Api       types.String `tfsdk:"api"`
+	Resource  types.String `tfsdk:"resource"`
+	Token     types.String `tfsdk:"token"`
+	ExpiresOn types.String `tfsdk:"expires_on"`
+}
+
+// accessTokenRequest is stored in the private data, so that Renew can request a token for the same API
+type accessTokenRequest struct {
+	Api      string `json:"api,omitempty"`
+	Resource string `json:"resource,omitempty"`
+}
+
+const (
+	accessTokenPrivateDataKey = "access_token_request"
+
+	// accessTokenRenewBuffer is how long before the token expires that Terraform is asked to renew it
+	accessTokenRenewBuffer = 5 * time.Minute
+)
+
+// accessTokenApis are the APIs within the Environment which a token can be requested for by name
+var accessTokenApis = map[string]func(environments.Environment) environments.Api{
+	"batch": func(e environments.Environment) environments.Api {
+		return e.Batch
+	},
+	"key_vault": func(e environments.Environment) environments.Api {
+		return e.KeyVault
+	},
+	"managed_hsm": func(e environments.Environment) environments.Api {
+		return e.ManagedHSM
+	},
+	"microsoft_graph": func(e environments.Environment) environments.Api {
+		return e.MicrosoftGraph
+	},
+	"resource_manager": func(e environments.Environment) environments.Api {
+		return e.ResourceManager
+	},
+	"storage": func(e environments.Environment) environments.Api {
+		return e.Storage
+	},
+	"synapse": func(e environments.Environment) environments.Api {
+		return e.Synapse
+	},
+}
+
+func (e *AccessTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_access_token"
+}
+
+func (e *AccessTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *AccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	apis := make([]string, 0)
+	for k := range accessTokenApis {
+		apis = append(apis, k)
+	}
+	sort.Strings(apis)
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(apis...),
+					stringvalidator.ConflictsWith(path.MatchRoot("resource")),
+				},
+			},
+
+			"resource": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires_on": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *AccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data AccessTokenEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	request := accessTokenRequest{
+		Api:      data.Api.ValueString(),
+		Resource: data.Resource.ValueString(),
+	}
+
+	token, err := e.token(ctx, request)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "obtaining access token", err)
+		return
+	}
+
+	data.Token = types.StringValue(token.AccessToken)
+	data.ExpiresOn = types.StringNull()
+	if !token.Expiry.IsZero() {
+		data.ExpiresOn = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+		resp.RenewAt = token.Expiry.Add(-accessTokenRenewBuffer)
+	}
+
+	privateData, err := json.Marshal(request)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "marshaling private data", err)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, accessTokenPrivateDataKey, privateData)...)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Renew obtains a new token from the Provider's credentials, which ensures the credentials remain valid whilst
+// the token is in use. Terraform doesn't update the result of an Ephemeral Resource during Renew, so consumers
+// needing a token for longer than its lifetime should request it again in a subsequent operation.
+func (e *AccessTokenEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	privateData, diags := req.Private.GetKey(ctx, accessTokenPrivateDataKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var request accessTokenRequest
+	if err := json.Unmarshal(privateData, &request); err != nil {
+		resp.Diagnostics.AddError("renewing access token", fmt.Sprintf("unmarshaling private data: %+v", err))
+		return
+	}
+
+	token, err := e.token(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("renewing access token", err.Error())
+		return
+	}
+
+	if !token.Expiry.IsZero() {
+		resp.RenewAt = token.Expiry.Add(-accessTokenRenewBuffer)
+	}
+}
+
+func (e *AccessTokenEphemeralResource) token(ctx context.Context, request accessTokenRequest) (*oauth2.Token, error) {
+	if e.Client.Authorizers == nil || e.Client.Authorizers.AuthorizerFunc == nil {
+		return nil, fmt.Errorf("the Provider has not been configured with credentials")
+	}
+
+	var api environments.Api
+	if request.Resource != "" {
+		api = environments.NewApiEndpoint("Custom", request.Resource, nil).WithResourceIdentifier(request.Resource)
+	} else {
+		name := request.Api
+		if name == "" {
+			name = "resource_manager"
+		}
+
+		apiFunc, ok := accessTokenApis[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported API %q", name)
+		}
+		api = apiFunc(e.Client.Account.Environment)
+		if api == nil || !api.Available() {
+			return nil, fmt.Errorf("the API %q is not available in the %q Environment", name, e.Client.Account.Environment.Name)
+		}
+	}
+
+	authorizer, err := e.Client.Authorizers.AuthorizerFunc(api)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := authorizer.Token(ctx, &http.Request{})
+	if err != nil {
+		return nil, fmt.Errorf("obtaining token for %q: %+v", api.Name(), err)
+	}
+	if token == nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("an empty token was returned for %q", api.Name())
+	}
+
+	return token, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StackHCIVirtualMachineInstanceId{}
+
+type StackHCIVirtualMachineInstanceId struct {
+	Scope string
+}
+
+func NewStackHCIVirtualMachineInstanceID(scope string) StackHCIVirtualMachineInstanceId {
+	return StackHCIVirtualMachineInstanceId{
+		Scope: scope,
+	}
+}
+
+func StackHCIVirtualMachineInstanceID(input string) (*StackHCIVirtualMachineInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StackHCIVirtualMachineInstanceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StackHCIVirtualMachineInstanceId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func StackHCIVirtualMachineInstanceIDInsensitively(input string) (*StackHCIVirtualMachineInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StackHCIVirtualMachineInstanceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StackHCIVirtualMachineInstanceId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StackHCIVirtualMachineInstanceId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.Scope, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	return nil
+}
+
+func (id StackHCIVirtualMachineInstanceId) ID() string {
+	fmtString := "/%s/providers/Microsoft.AzureStackHCI/virtualMachineInstances/default"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"))
+}
+
+func (id StackHCIVirtualMachineInstanceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group/providers/Microsoft.HybridCompute/machines/some-machine"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAzureStackHCI", "Microsoft.AzureStackHCI", "Microsoft.AzureStackHCI"),
+		resourceids.StaticSegment("staticVirtualMachineInstances", "virtualMachineInstances", "virtualMachineInstances"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	}
+}
+
+func (id StackHCIVirtualMachineInstanceId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+	}
+	return fmt.Sprintf("Stack HCI Virtual Machine Instance (%s)", strings.Join(components, "\n"))
+}
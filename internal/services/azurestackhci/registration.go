@@ -4,6 +4,9 @@
 package azurestackhci
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,6 +16,7 @@ type Registration struct{}
 var (
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
+	_ sdk.FrameworkServiceRegistration               = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -46,6 +50,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		StackHCIClusterDataSource{},
+		StackHCIClusterUpdateSummaryDataSource{},
 		StackHCIStoragePathDataSource{},
 	}
 }
@@ -59,5 +64,28 @@ func (r Registration) Resources() []sdk.Resource {
 		StackHCINetworkInterfaceResource{},
 		StackHCIStoragePathResource{},
 		StackHCIVirtualHardDiskResource{},
+		StackHCIVirtualMachineInstanceResource{},
 	}
 }
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newStackHCIClusterUpdateAction,
+	}
+}
+
+func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
+	return []sdk.FrameworkWrappedResource{}
+}
+
+func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
+	return []sdk.FrameworkWrappedDataSource{}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/updateruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/updates"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type StackHCIClusterUpdateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &StackHCIClusterUpdateAction{}
+
+func newStackHCIClusterUpdateAction() action.Action {
+	return &StackHCIClusterUpdateAction{}
+}
+
+type StackHCIClusterUpdateActionModel struct {
+	UpdateId types.String `tfsdk:"update_id"`
+	Timeouts types.Object `tfsdk:"timeouts"`
+}
+
+func (s *StackHCIClusterUpdateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"update_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Stack HCI Cluster Update to apply.",
+				MarkdownDescription: "The ID of the Stack HCI Cluster Update to apply.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: updates.ValidateUpdateID,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sdk.ActionTimeoutsBlock(),
+		},
+	}
+}
+
+func (s *StackHCIClusterUpdateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_stack_hci_cluster_update"
+}
+
+func (s *StackHCIClusterUpdateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := s.Client.AzureStackHCI.Updates
+	runsClient := s.Client.AzureStackHCI.UpdateRuns
+
+	model := StackHCIClusterUpdateActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := s.InvokeTimeout(ctx, model.Timeouts, time.Hour*24)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := updates.ParseUpdateID(model.UpdateId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+	if existing.Model != nil && existing.Model.Properties != nil {
+		if state := pointer.From(existing.Model.Properties.State); state == updates.StateInstalled {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("%s has already been installed", id))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("applying update %s to %s", id.UpdateName, id.ClusterName),
+	})
+
+	result, err := client.Post(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("applying %s: %+v", id, err))
+		return
+	}
+	if err := s.PollWithProgress(ctx, response, fmt.Sprintf("applying update %s to %s", id.UpdateName, id.ClusterName), result.Poller); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	runs, err := runsClient.ListComplete(ctx, updateruns.NewUpdateID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, id.UpdateName))
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("listing update runs for %s: %+v", id, err))
+		return
+	}
+
+	if run := latestStackHCIClusterUpdateRun(runs.Items); run != nil && run.Properties != nil {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("applying update %s to %s completed with state %s", id.UpdateName, id.ClusterName, pointer.From(run.Properties.State)),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("applying update %s to %s completed", id.UpdateName, id.ClusterName),
+	})
+}
+
+func (s *StackHCIClusterUpdateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	s.Defaults(ctx, request, response)
+}
+
+// latestStackHCIClusterUpdateRun returns the most recently started run of an Update, since the API returns them unordered
+func latestStackHCIClusterUpdateRun(input []updateruns.UpdateRun) *updateruns.UpdateRun {
+	var latest *updateruns.UpdateRun
+	var latestStarted time.Time
+
+	for i, run := range input {
+		if run.Properties == nil {
+			continue
+		}
+
+		started, err := run.Properties.GetTimeStartedAsTime()
+		if err != nil || started == nil {
+			continue
+		}
+
+		if latest == nil || started.After(latestStarted) {
+			latest = &input[i]
+			latestStarted = *started
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+const updateIdEnv = "ARM_TEST_STACK_HCI_UPDATE_ID"
+
+type StackHCIClusterUpdateAction struct{}
+
+// updates are published by the service for deployed clusters and can't be created on demand, so this test targets an existing one
+func TestAccStackHCIClusterUpdateAction_basic(t *testing.T) {
+	if os.Getenv(updateIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", updateIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_cluster_update", "test")
+	a := StackHCIClusterUpdateAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *StackHCIClusterUpdateAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "terraform_data" "trigger" {
+  input = "%[1]d"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_stack_hci_cluster_update.test]
+    }
+  }
+}
+
+action "azurerm_stack_hci_cluster_update" "test" {
+  config {
+    update_id = %[2]q
+  }
+}
+`, data.RandomInteger, os.Getenv(updateIdEnv))
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/updates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/updatesummaries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = StackHCIClusterUpdateSummaryDataSource{}
+
+type StackHCIClusterUpdateSummaryDataSource struct{}
+
+func (r StackHCIClusterUpdateSummaryDataSource) ResourceType() string {
+	return "azurerm_stack_hci_cluster_update_summary"
+}
+
+func (r StackHCIClusterUpdateSummaryDataSource) ModelObject() interface{} {
+	return &StackHCIClusterUpdateSummaryDataSourceModel{}
+}
+
+type StackHCIClusterUpdateSummaryDataSourceModel struct {
+	StackHCIClusterId string                                             `tfschema:"stack_hci_cluster_id"`
+	AvailableUpdate   []StackHCIClusterUpdateSummaryAvailableUpdateModel `tfschema:"available_update"`
+	CurrentVersion    string                                             `tfschema:"current_version"`
+	HardwareModel     string                                             `tfschema:"hardware_model"`
+	HealthCheckDate   string                                             `tfschema:"health_check_date"`
+	HealthState       string                                             `tfschema:"health_state"`
+	LastChecked       string                                             `tfschema:"last_checked"`
+	LastUpdated       string                                             `tfschema:"last_updated"`
+	OemFamily         string                                             `tfschema:"oem_family"`
+	PackageVersion    []StackHCIClusterUpdateSummaryPackageVersionModel  `tfschema:"package_version"`
+	State             string                                             `tfschema:"state"`
+}
+
+type StackHCIClusterUpdateSummaryAvailableUpdateModel struct {
+	Id               string `tfschema:"id"`
+	Name             string `tfschema:"name"`
+	AvailabilityType string `tfschema:"availability_type"`
+	Description      string `tfschema:"description"`
+	DisplayName      string `tfschema:"display_name"`
+	HealthState      string `tfschema:"health_state"`
+	InstalledDate    string `tfschema:"installed_date"`
+	PackageType      string `tfschema:"package_type"`
+	Publisher        string `tfschema:"publisher"`
+	RebootRequired   string `tfschema:"reboot_required"`
+	ReleaseLink      string `tfschema:"release_link"`
+	State            string `tfschema:"state"`
+	Version          string `tfschema:"version"`
+}
+
+type StackHCIClusterUpdateSummaryPackageVersionModel struct {
+	LastUpdated string `tfschema:"last_updated"`
+	PackageType string `tfschema:"package_type"`
+	Version     string `tfschema:"version"`
+}
+
+func (r StackHCIClusterUpdateSummaryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"stack_hci_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: updatesummaries.ValidateClusterID,
+		},
+	}
+}
+
+func (r StackHCIClusterUpdateSummaryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"available_update": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"availability_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"health_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"installed_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"package_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"publisher": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"reboot_required": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"release_link": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"current_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"hardware_model": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"health_check_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"health_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_checked": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_updated": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"oem_family": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"package_version": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"last_updated": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"package_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StackHCIClusterUpdateSummaryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			summariesClient := metadata.Client.AzureStackHCI.UpdateSummaries
+			updatesClient := metadata.Client.AzureStackHCI.Updates
+
+			var state StackHCIClusterUpdateSummaryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := updatesummaries.ParseClusterID(state.StackHCIClusterId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := summariesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("update summary for %s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving update summary for %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.CurrentVersion = pointer.From(props.CurrentVersion)
+					state.HardwareModel = pointer.From(props.HardwareModel)
+					state.HealthCheckDate = pointer.From(props.HealthCheckDate)
+					state.HealthState = string(pointer.From(props.HealthState))
+					state.LastChecked = pointer.From(props.LastChecked)
+					state.LastUpdated = pointer.From(props.LastUpdated)
+					state.OemFamily = pointer.From(props.OemFamily)
+					state.PackageVersion = flattenStackHCIClusterUpdateSummaryPackageVersions(props.PackageVersions)
+					state.State = string(pointer.From(props.State))
+				}
+			}
+
+			updatesResp, err := updatesClient.ListComplete(ctx, updates.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName))
+			if err != nil {
+				return fmt.Errorf("listing updates for %s: %+v", id, err)
+			}
+
+			state.AvailableUpdate = flattenStackHCIClusterUpdateSummaryAvailableUpdates(updatesResp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenStackHCIClusterUpdateSummaryPackageVersions(input *[]updatesummaries.PackageVersionInfo) []StackHCIClusterUpdateSummaryPackageVersionModel {
+	output := make([]StackHCIClusterUpdateSummaryPackageVersionModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		output = append(output, StackHCIClusterUpdateSummaryPackageVersionModel{
+			LastUpdated: pointer.From(item.LastUpdated),
+			PackageType: pointer.From(item.PackageType),
+			Version:     pointer.From(item.Version),
+		})
+	}
+
+	return output
+}
+
+func flattenStackHCIClusterUpdateSummaryAvailableUpdates(input []updates.Update) []StackHCIClusterUpdateSummaryAvailableUpdateModel {
+	output := make([]StackHCIClusterUpdateSummaryAvailableUpdateModel, 0, len(input))
+
+	for _, item := range input {
+		update := StackHCIClusterUpdateSummaryAvailableUpdateModel{
+			Name: pointer.From(item.Name),
+		}
+
+		if item.Id != nil {
+			updateId, err := updates.ParseUpdateIDInsensitively(*item.Id)
+			if err == nil {
+				update.Id = updateId.ID()
+			} else {
+				update.Id = *item.Id
+			}
+		}
+
+		if props := item.Properties; props != nil {
+			update.AvailabilityType = string(pointer.From(props.AvailabilityType))
+			update.Description = pointer.From(props.Description)
+			update.DisplayName = pointer.From(props.DisplayName)
+			update.HealthState = string(pointer.From(props.HealthState))
+			update.InstalledDate = pointer.From(props.InstalledDate)
+			update.PackageType = pointer.From(props.PackageType)
+			update.Publisher = pointer.From(props.Publisher)
+			update.RebootRequired = string(pointer.From(props.RebootRequired))
+			update.ReleaseLink = pointer.From(props.ReleaseLink)
+			update.State = string(pointer.From(props.State))
+			update.Version = pointer.From(props.Version)
+		}
+
+		output = append(output, update)
+	}
+
+	return output
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+const clusterIdEnv = "ARM_TEST_STACK_HCI_CLUSTER_ID"
+
+type StackHCIClusterUpdateSummaryDataSource struct{}
+
+// the update summary is only populated once a cluster has been deployed, so this test targets an existing cluster
+func TestAccStackHCIClusterUpdateSummaryDataSource_basic(t *testing.T) {
+	if os.Getenv(clusterIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", clusterIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_stack_hci_cluster_update_summary", "test")
+	d := StackHCIClusterUpdateSummaryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("current_version").IsNotEmpty(),
+				check.That(data.ResourceName).Key("state").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (d StackHCIClusterUpdateSummaryDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_stack_hci_cluster_update_summary" "test" {
+  stack_hci_cluster_id = %q
+}
+`, os.Getenv(clusterIdEnv))
+}
@@ -0,0 +1,1050 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/galleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualharddisks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = StackHCIVirtualMachineInstanceResource{}
+	_ sdk.ResourceWithUpdate = StackHCIVirtualMachineInstanceResource{}
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+func (StackHCIVirtualMachineInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StackHCIVirtualMachineInstanceID
+}
+
+func (StackHCIVirtualMachineInstanceResource) ResourceType() string {
+	return "azurerm_stack_hci_virtual_machine_instance"
+}
+
+func (StackHCIVirtualMachineInstanceResource) ModelObject() interface{} {
+	return &StackHCIVirtualMachineInstanceResourceModel{}
+}
+
+type StackHCIVirtualMachineInstanceResourceModel struct {
+	ArcMachineId           string                                       `tfschema:"arc_machine_id"`
+	CustomLocationId       string                                       `tfschema:"custom_location_id"`
+	HardwareProfile        []StackHCIVirtualMachineHardwareProfileModel `tfschema:"hardware_profile"`
+	HttpProxyConfiguration []StackHCIVirtualMachineHttpProxyModel       `tfschema:"http_proxy_configuration"`
+	NetworkInterfaceIds    []string                                     `tfschema:"network_interface_ids"`
+	OsProfile              []StackHCIVirtualMachineOsProfileModel       `tfschema:"os_profile"`
+	SecurityProfile        []StackHCIVirtualMachineSecurityProfileModel `tfschema:"security_profile"`
+	StorageProfile         []StackHCIVirtualMachineStorageProfileModel  `tfschema:"storage_profile"`
+	PowerState             string                                       `tfschema:"power_state"`
+	VirtualMachineId       string                                       `tfschema:"virtual_machine_id"`
+}
+
+type StackHCIVirtualMachineHardwareProfileModel struct {
+	VmSize          string                                     `tfschema:"vm_size"`
+	ProcessorNumber int64                                      `tfschema:"processor_number"`
+	MemoryInMb      int64                                      `tfschema:"memory_in_mb"`
+	DynamicMemory   []StackHCIVirtualMachineDynamicMemoryModel `tfschema:"dynamic_memory"`
+}
+
+type StackHCIVirtualMachineDynamicMemoryModel struct {
+	MaximumMemoryInMb            int64 `tfschema:"maximum_memory_in_mb"`
+	MinimumMemoryInMb            int64 `tfschema:"minimum_memory_in_mb"`
+	TargetMemoryBufferPercentage int64 `tfschema:"target_memory_buffer_percentage"`
+}
+
+type StackHCIVirtualMachineHttpProxyModel struct {
+	HttpProxy  string   `tfschema:"http_proxy"`
+	HttpsProxy string   `tfschema:"https_proxy"`
+	NoProxy    []string `tfschema:"no_proxy"`
+	TrustedCa  string   `tfschema:"trusted_ca"`
+}
+
+type StackHCIVirtualMachineOsProfileModel struct {
+	AdminUsername        string                                            `tfschema:"admin_username"`
+	AdminPassword        string                                            `tfschema:"admin_password"`
+	ComputerName         string                                            `tfschema:"computer_name"`
+	LinuxConfiguration   []StackHCIVirtualMachineLinuxConfigurationModel   `tfschema:"linux_configuration"`
+	WindowsConfiguration []StackHCIVirtualMachineWindowsConfigurationModel `tfschema:"windows_configuration"`
+}
+
+type StackHCIVirtualMachineLinuxConfigurationModel struct {
+	PasswordAuthenticationEnabled bool                                      `tfschema:"password_authentication_enabled"`
+	ProvisionVmAgentEnabled       bool                                      `tfschema:"provision_vm_agent_enabled"`
+	ProvisionVmConfigAgentEnabled bool                                      `tfschema:"provision_vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVirtualMachineSshPublicKeyModel `tfschema:"ssh_public_key"`
+}
+
+type StackHCIVirtualMachineWindowsConfigurationModel struct {
+	AutomaticUpdateEnabled        bool                                      `tfschema:"automatic_update_enabled"`
+	ProvisionVmAgentEnabled       bool                                      `tfschema:"provision_vm_agent_enabled"`
+	ProvisionVmConfigAgentEnabled bool                                      `tfschema:"provision_vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVirtualMachineSshPublicKeyModel `tfschema:"ssh_public_key"`
+	TimeZone                      string                                    `tfschema:"time_zone"`
+}
+
+type StackHCIVirtualMachineSshPublicKeyModel struct {
+	KeyData string `tfschema:"key_data"`
+	Path    string `tfschema:"path"`
+}
+
+type StackHCIVirtualMachineSecurityProfileModel struct {
+	SecureBootEnabled bool   `tfschema:"secure_boot_enabled"`
+	SecurityType      string `tfschema:"security_type"`
+	TpmEnabled        bool   `tfschema:"tpm_enabled"`
+}
+
+type StackHCIVirtualMachineStorageProfileModel struct {
+	DataDiskIds           []string `tfschema:"data_disk_ids"`
+	ImageId               string   `tfschema:"image_id"`
+	OsDiskId              string   `tfschema:"os_disk_id"`
+	OsType                string   `tfschema:"os_type"`
+	VmConfigStoragePathId string   `tfschema:"vm_config_storage_path_id"`
+}
+
+func (StackHCIVirtualMachineInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	sshPublicKeySchema := &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"key_data": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"custom_location_id": commonschema.ResourceIDReferenceRequiredForceNew(&customlocations.CustomLocationId{}),
+
+		"hardware_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForVMSizeEnum(), false),
+					},
+
+					"processor_number": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"dynamic_memory": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"maximum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"minimum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"target_memory_buffer_percentage": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntBetween(5, 2000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"network_interface_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkinterfaces.ValidateNetworkInterfaceID,
+			},
+		},
+
+		"os_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"computer_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 15),
+					},
+
+					"admin_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"linux_configuration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						ExactlyOneOf: []string{
+							"os_profile.0.linux_configuration",
+							"os_profile.0.windows_configuration",
+						},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"password_authentication_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"provision_vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"provision_vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"ssh_public_key": sshPublicKeySchema,
+							},
+						},
+					},
+
+					"windows_configuration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						ExactlyOneOf: []string{
+							"os_profile.0.linux_configuration",
+							"os_profile.0.windows_configuration",
+						},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"automatic_update_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"provision_vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"provision_vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"ssh_public_key": sshPublicKeySchema,
+
+								"time_zone": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"storage_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image_id": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						ValidateFunc: validation.Any(
+							marketplacegalleryimages.ValidateMarketplaceGalleryImageID,
+							galleryimages.ValidateGalleryImageID,
+						),
+						ExactlyOneOf: []string{
+							"storage_profile.0.image_id",
+							"storage_profile.0.os_disk_id",
+						},
+					},
+
+					"os_disk_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: virtualharddisks.ValidateVirtualHardDiskID,
+						ExactlyOneOf: []string{
+							"storage_profile.0.image_id",
+							"storage_profile.0.os_disk_id",
+						},
+						RequiredWith: []string{
+							"storage_profile.0.os_type",
+						},
+					},
+
+					"os_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForOperatingSystemTypes(), false),
+					},
+
+					"data_disk_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: virtualharddisks.ValidateVirtualHardDiskID,
+						},
+					},
+
+					"vm_config_storage_path_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: storagecontainers.ValidateStorageContainerID,
+					},
+				},
+			},
+		},
+
+		"http_proxy_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"http_proxy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"https_proxy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"no_proxy": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"trusted_ca": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"security_profile": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"secure_boot_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"security_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForSecurityTypes(), false),
+					},
+
+					"tpm_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (StackHCIVirtualMachineInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"power_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			var config StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewStackHCIVirtualMachineInstanceID(config.ArcMachineId)
+			scopeId := commonids.NewScopeID(id.Scope)
+
+			existing, err := client.Get(ctx, scopeId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := virtualmachineinstances.VirtualMachineInstance{
+				ExtendedLocation: &virtualmachineinstances.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(virtualmachineinstances.ExtendedLocationTypesCustomLocation),
+				},
+				Properties: &virtualmachineinstances.VirtualMachineInstanceProperties{
+					HardwareProfile: expandStackHCIVirtualMachineHardwareProfile(config.HardwareProfile),
+					HTTPProxyConfig: expandStackHCIVirtualMachineHttpProxyConfiguration(config.HttpProxyConfiguration),
+					NetworkProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile{
+						NetworkInterfaces: expandStackHCIVirtualMachineNetworkInterfaces(config.NetworkInterfaceIds),
+					},
+					OsProfile:       expandStackHCIVirtualMachineOsProfile(config.OsProfile),
+					SecurityProfile: expandStackHCIVirtualMachineSecurityProfile(config.SecurityProfile),
+					StorageProfile:  expandStackHCIVirtualMachineStorageProfile(config.StorageProfile),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, scopeId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHCIVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, commonids.NewScopeID(id.Scope))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCIVirtualMachineInstanceResourceModel{
+				ArcMachineId: id.Scope,
+			}
+
+			if model := resp.Model; model != nil {
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+
+					state.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					// the API doesn't return the admin password or the http proxy configuration, so keep the configured values
+					var config StackHCIVirtualMachineInstanceResourceModel
+					if err := metadata.Decode(&config); err != nil {
+						return fmt.Errorf("decoding: %+v", err)
+					}
+
+					state.HardwareProfile = flattenStackHCIVirtualMachineHardwareProfile(props.HardwareProfile)
+					state.HttpProxyConfiguration = config.HttpProxyConfiguration
+					state.NetworkInterfaceIds = flattenStackHCIVirtualMachineNetworkInterfaces(props.NetworkProfile)
+					state.OsProfile = flattenStackHCIVirtualMachineOsProfile(props.OsProfile, config.OsProfile)
+					state.SecurityProfile = flattenStackHCIVirtualMachineSecurityProfile(props.SecurityProfile)
+					state.VirtualMachineId = pointer.From(props.VMId)
+
+					storageProfile, err := flattenStackHCIVirtualMachineStorageProfile(props.StorageProfile)
+					if err != nil {
+						return err
+					}
+					state.StorageProfile = storageProfile
+
+					if status := props.Status; status != nil {
+						state.PowerState = string(pointer.From(status.PowerState))
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHCIVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := virtualmachineinstances.VirtualMachineInstanceUpdateRequest{
+				Properties: &virtualmachineinstances.VirtualMachineInstanceUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("hardware_profile") {
+				hardwareProfile := expandStackHCIVirtualMachineHardwareProfile(config.HardwareProfile)
+				parameters.Properties.HardwareProfile = &virtualmachineinstances.HardwareProfileUpdate{
+					MemoryMB:   hardwareProfile.MemoryMB,
+					Processors: hardwareProfile.Processors,
+					VMSize:     hardwareProfile.VMSize,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("network_interface_ids") {
+				networkInterfaces := make([]virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesInlined, 0)
+				for _, v := range config.NetworkInterfaceIds {
+					networkInterfaces = append(networkInterfaces, virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesInlined{
+						Id: pointer.To(v),
+					})
+				}
+				parameters.Properties.NetworkProfile = &virtualmachineinstances.NetworkProfileUpdate{
+					NetworkInterfaces: &networkInterfaces,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("storage_profile.0.data_disk_ids") {
+				dataDisks := make([]virtualmachineinstances.StorageProfileUpdateDataDisksInlined, 0)
+				if len(config.StorageProfile) > 0 {
+					for _, v := range config.StorageProfile[0].DataDiskIds {
+						dataDisks = append(dataDisks, virtualmachineinstances.StorageProfileUpdateDataDisksInlined{
+							Id: pointer.To(v),
+						})
+					}
+				}
+				parameters.Properties.StorageProfile = &virtualmachineinstances.StorageProfileUpdate{
+					DataDisks: &dataDisks,
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, commonids.NewScopeID(id.Scope), parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHCIVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, commonids.NewScopeID(id.Scope)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineHardwareProfile(input []StackHCIVirtualMachineHardwareProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile{
+		VMSize: pointer.To(virtualmachineinstances.VMSizeEnum(v.VmSize)),
+	}
+
+	if v.ProcessorNumber != 0 {
+		output.Processors = pointer.To(v.ProcessorNumber)
+	}
+
+	if v.MemoryInMb != 0 {
+		output.MemoryMB = pointer.To(v.MemoryInMb)
+	}
+
+	if len(v.DynamicMemory) > 0 {
+		dynamicMemory := v.DynamicMemory[0]
+		output.DynamicMemoryConfig = &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig{
+			MaximumMemoryMB: pointer.To(dynamicMemory.MaximumMemoryInMb),
+			MinimumMemoryMB: pointer.To(dynamicMemory.MinimumMemoryInMb),
+		}
+
+		if dynamicMemory.TargetMemoryBufferPercentage != 0 {
+			output.DynamicMemoryConfig.TargetMemoryBuffer = pointer.To(dynamicMemory.TargetMemoryBufferPercentage)
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineHardwareProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile) []StackHCIVirtualMachineHardwareProfileModel {
+	if input == nil {
+		return make([]StackHCIVirtualMachineHardwareProfileModel, 0)
+	}
+
+	output := StackHCIVirtualMachineHardwareProfileModel{
+		VmSize:          string(pointer.From(input.VMSize)),
+		ProcessorNumber: pointer.From(input.Processors),
+		MemoryInMb:      pointer.From(input.MemoryMB),
+	}
+
+	if v := input.DynamicMemoryConfig; v != nil && (v.MaximumMemoryMB != nil || v.MinimumMemoryMB != nil) {
+		output.DynamicMemory = []StackHCIVirtualMachineDynamicMemoryModel{
+			{
+				MaximumMemoryInMb:            pointer.From(v.MaximumMemoryMB),
+				MinimumMemoryInMb:            pointer.From(v.MinimumMemoryMB),
+				TargetMemoryBufferPercentage: pointer.From(v.TargetMemoryBuffer),
+			},
+		}
+	}
+
+	return []StackHCIVirtualMachineHardwareProfileModel{output}
+}
+
+func expandStackHCIVirtualMachineHttpProxyConfiguration(input []StackHCIVirtualMachineHttpProxyModel) *virtualmachineinstances.HTTPProxyConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.HTTPProxyConfiguration{}
+
+	if v.HttpProxy != "" {
+		output.HTTPProxy = pointer.To(v.HttpProxy)
+	}
+
+	if v.HttpsProxy != "" {
+		output.HTTPSProxy = pointer.To(v.HttpsProxy)
+	}
+
+	if len(v.NoProxy) > 0 {
+		output.NoProxy = pointer.To(v.NoProxy)
+	}
+
+	if v.TrustedCa != "" {
+		output.TrustedCa = pointer.To(v.TrustedCa)
+	}
+
+	return output
+}
+
+func expandStackHCIVirtualMachineNetworkInterfaces(input []string) *[]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined {
+	output := make([]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined, 0)
+	for _, v := range input {
+		output = append(output, virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined{
+			Id: pointer.To(v),
+		})
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineNetworkInterfaces(input *virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile) []string {
+	output := make([]string, 0)
+	if input == nil || input.NetworkInterfaces == nil {
+		return output
+	}
+
+	for _, v := range *input.NetworkInterfaces {
+		if v.Id == nil {
+			continue
+		}
+
+		networkInterfaceId, err := networkinterfaces.ParseNetworkInterfaceIDInsensitively(*v.Id)
+		if err != nil {
+			output = append(output, *v.Id)
+			continue
+		}
+
+		output = append(output, networkInterfaceId.ID())
+	}
+
+	return output
+}
+
+func expandStackHCIVirtualMachineOsProfile(input []StackHCIVirtualMachineOsProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile{
+		AdminUsername: pointer.To(v.AdminUsername),
+		ComputerName:  pointer.To(v.ComputerName),
+	}
+
+	if v.AdminPassword != "" {
+		output.AdminPassword = pointer.To(v.AdminPassword)
+	}
+
+	if len(v.LinuxConfiguration) > 0 {
+		linux := v.LinuxConfiguration[0]
+		output.LinuxConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileLinuxConfiguration{
+			DisablePasswordAuthentication: pointer.To(!linux.PasswordAuthenticationEnabled),
+			ProvisionVMAgent:              pointer.To(linux.ProvisionVmAgentEnabled),
+			ProvisionVMConfigAgent:        pointer.To(linux.ProvisionVmConfigAgentEnabled),
+			Ssh:                           expandStackHCIVirtualMachineSshConfiguration(linux.SshPublicKey),
+		}
+	}
+
+	if len(v.WindowsConfiguration) > 0 {
+		windows := v.WindowsConfiguration[0]
+		output.WindowsConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileWindowsConfiguration{
+			EnableAutomaticUpdates: pointer.To(windows.AutomaticUpdateEnabled),
+			ProvisionVMAgent:       pointer.To(windows.ProvisionVmAgentEnabled),
+			ProvisionVMConfigAgent: pointer.To(windows.ProvisionVmConfigAgentEnabled),
+			Ssh:                    expandStackHCIVirtualMachineSshConfiguration(windows.SshPublicKey),
+		}
+
+		if windows.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = pointer.To(windows.TimeZone)
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineOsProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile, config []StackHCIVirtualMachineOsProfileModel) []StackHCIVirtualMachineOsProfileModel {
+	if input == nil {
+		return make([]StackHCIVirtualMachineOsProfileModel, 0)
+	}
+
+	output := StackHCIVirtualMachineOsProfileModel{
+		AdminUsername: pointer.From(input.AdminUsername),
+		ComputerName:  pointer.From(input.ComputerName),
+	}
+
+	if len(config) > 0 {
+		output.AdminPassword = config[0].AdminPassword
+	}
+
+	if v := input.LinuxConfiguration; v != nil {
+		output.LinuxConfiguration = []StackHCIVirtualMachineLinuxConfigurationModel{
+			{
+				PasswordAuthenticationEnabled: !pointer.From(v.DisablePasswordAuthentication),
+				ProvisionVmAgentEnabled:       pointer.From(v.ProvisionVMAgent),
+				ProvisionVmConfigAgentEnabled: pointer.From(v.ProvisionVMConfigAgent),
+				SshPublicKey:                  flattenStackHCIVirtualMachineSshConfiguration(v.Ssh),
+			},
+		}
+	}
+
+	if v := input.WindowsConfiguration; v != nil {
+		output.WindowsConfiguration = []StackHCIVirtualMachineWindowsConfigurationModel{
+			{
+				AutomaticUpdateEnabled:        pointer.From(v.EnableAutomaticUpdates),
+				ProvisionVmAgentEnabled:       pointer.From(v.ProvisionVMAgent),
+				ProvisionVmConfigAgentEnabled: pointer.From(v.ProvisionVMConfigAgent),
+				SshPublicKey:                  flattenStackHCIVirtualMachineSshConfiguration(v.Ssh),
+				TimeZone:                      pointer.From(v.TimeZone),
+			},
+		}
+	}
+
+	return []StackHCIVirtualMachineOsProfileModel{output}
+}
+
+func expandStackHCIVirtualMachineSshConfiguration(input []StackHCIVirtualMachineSshPublicKeyModel) *virtualmachineinstances.SshConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	publicKeys := make([]virtualmachineinstances.SshPublicKey, 0)
+	for _, v := range input {
+		publicKeys = append(publicKeys, virtualmachineinstances.SshPublicKey{
+			KeyData: pointer.To(v.KeyData),
+			Path:    pointer.To(v.Path),
+		})
+	}
+
+	return &virtualmachineinstances.SshConfiguration{
+		PublicKeys: &publicKeys,
+	}
+}
+
+func flattenStackHCIVirtualMachineSshConfiguration(input *virtualmachineinstances.SshConfiguration) []StackHCIVirtualMachineSshPublicKeyModel {
+	output := make([]StackHCIVirtualMachineSshPublicKeyModel, 0)
+	if input == nil || input.PublicKeys == nil {
+		return output
+	}
+
+	for _, v := range *input.PublicKeys {
+		output = append(output, StackHCIVirtualMachineSshPublicKeyModel{
+			KeyData: pointer.From(v.KeyData),
+			Path:    pointer.From(v.Path),
+		})
+	}
+
+	return output
+}
+
+func expandStackHCIVirtualMachineSecurityProfile(input []StackHCIVirtualMachineSecurityProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile{
+		EnableTPM: pointer.To(v.TpmEnabled),
+		UefiSettings: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfileUefiSettings{
+			SecureBootEnabled: pointer.To(v.SecureBootEnabled),
+		},
+	}
+
+	if v.SecurityType != "" {
+		output.SecurityType = pointer.To(virtualmachineinstances.SecurityTypes(v.SecurityType))
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineSecurityProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile) []StackHCIVirtualMachineSecurityProfileModel {
+	if input == nil {
+		return make([]StackHCIVirtualMachineSecurityProfileModel, 0)
+	}
+
+	output := StackHCIVirtualMachineSecurityProfileModel{
+		SecurityType: string(pointer.From(input.SecurityType)),
+		TpmEnabled:   pointer.From(input.EnableTPM),
+	}
+
+	if input.UefiSettings != nil {
+		output.SecureBootEnabled = pointer.From(input.UefiSettings.SecureBootEnabled)
+	}
+
+	return []StackHCIVirtualMachineSecurityProfileModel{output}
+}
+
+func expandStackHCIVirtualMachineStorageProfile(input []StackHCIVirtualMachineStorageProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile{}
+
+	if v.ImageId != "" {
+		output.ImageReference = &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileImageReference{
+			Id: pointer.To(v.ImageId),
+		}
+	}
+
+	if v.OsDiskId != "" || v.OsType != "" {
+		output.OsDisk = &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileOsDisk{}
+
+		if v.OsDiskId != "" {
+			output.OsDisk.Id = pointer.To(v.OsDiskId)
+		}
+
+		if v.OsType != "" {
+			output.OsDisk.OsType = pointer.To(virtualmachineinstances.OperatingSystemTypes(v.OsType))
+		}
+	}
+
+	if len(v.DataDiskIds) > 0 {
+		dataDisks := make([]virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined, 0)
+		for _, diskId := range v.DataDiskIds {
+			dataDisks = append(dataDisks, virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined{
+				Id: pointer.To(diskId),
+			})
+		}
+		output.DataDisks = &dataDisks
+	}
+
+	if v.VmConfigStoragePathId != "" {
+		output.VMConfigStoragePathId = pointer.To(v.VmConfigStoragePathId)
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineStorageProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile) ([]StackHCIVirtualMachineStorageProfileModel, error) {
+	if input == nil {
+		return make([]StackHCIVirtualMachineStorageProfileModel, 0), nil
+	}
+
+	output := StackHCIVirtualMachineStorageProfileModel{
+		DataDiskIds: make([]string, 0),
+	}
+
+	if input.ImageReference != nil {
+		output.ImageId = pointer.From(input.ImageReference.Id)
+	}
+
+	if input.OsDisk != nil {
+		output.OsType = string(pointer.From(input.OsDisk.OsType))
+
+		if input.OsDisk.Id != nil {
+			osDiskId, err := virtualharddisks.ParseVirtualHardDiskIDInsensitively(*input.OsDisk.Id)
+			if err != nil {
+				return nil, err
+			}
+			output.OsDiskId = osDiskId.ID()
+		}
+	}
+
+	if input.DataDisks != nil {
+		for _, v := range *input.DataDisks {
+			if v.Id == nil {
+				continue
+			}
+
+			dataDiskId, err := virtualharddisks.ParseVirtualHardDiskIDInsensitively(*v.Id)
+			if err != nil {
+				return nil, err
+			}
+			output.DataDiskIds = append(output.DataDiskIds, dataDiskId.ID())
+		}
+	}
+
+	if input.VMConfigStoragePathId != nil {
+		storagePathId, err := storagecontainers.ParseStorageContainerIDInsensitively(*input.VMConfigStoragePathId)
+		if err != nil {
+			return nil, err
+		}
+		output.VmConfigStoragePathId = storagePathId.ID()
+	}
+
+	return []StackHCIVirtualMachineStorageProfileModel{output}, nil
+}
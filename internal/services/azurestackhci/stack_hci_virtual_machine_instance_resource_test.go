@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+func TestAccStackHCIVirtualMachineInstance_basic(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_machine_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_complete(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password", "http_proxy_configuration"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_update(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func TestAccStackHCIVirtualMachineInstance_requiresImport(t *testing.T) {
+	if os.Getenv(customLocationIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", customLocationIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine_instance", "test")
+	r := StackHCIVirtualMachineInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StackHCIVirtualMachineInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.VirtualMachineInstances.Get(ctx, commonids.NewScopeID(id.Scope))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCIVirtualMachineInstanceResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id        = azurerm_arc_machine.test.id
+  custom_location_id    = %[2]q
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 2
+    memory_in_mb     = 8192
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+
+    windows_configuration {}
+  }
+
+  storage_profile {
+    image_id = azurerm_stack_hci_marketplace_gallery_image.test.id
+  }
+}
+`, template, os.Getenv(customLocationIdEnv))
+}
+
+func (r StackHCIVirtualMachineInstanceResource) update(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stack_hci_virtual_hard_disk" "test" {
+  name                = "acctest-vhd-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  disk_size_in_gb     = 2
+}
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id        = azurerm_arc_machine.test.id
+  custom_location_id    = %[2]q
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 4
+    memory_in_mb     = 16384
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+
+    windows_configuration {}
+  }
+
+  storage_profile {
+    image_id      = azurerm_stack_hci_marketplace_gallery_image.test.id
+    data_disk_ids = [azurerm_stack_hci_virtual_hard_disk.test.id]
+  }
+}
+`, template, os.Getenv(customLocationIdEnv), data.RandomString)
+}
+
+func (r StackHCIVirtualMachineInstanceResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stack_hci_storage_path" "test" {
+  name                = "acctest-sp-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  path                = "C:\\ClusterStorage\\UserStorage_2\\sp-%[3]s"
+}
+
+resource "azurerm_stack_hci_virtual_hard_disk" "test" {
+  name                = "acctest-vhd-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[2]q
+  disk_size_in_gb     = 2
+  storage_path_id     = azurerm_stack_hci_storage_path.test.id
+}
+
+resource "azurerm_stack_hci_virtual_machine_instance" "test" {
+  arc_machine_id        = azurerm_arc_machine.test.id
+  custom_location_id    = %[2]q
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 2
+    memory_in_mb     = 8192
+
+    dynamic_memory {
+      maximum_memory_in_mb            = 8192
+      minimum_memory_in_mb            = 512
+      target_memory_buffer_percentage = 20
+    }
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+
+    windows_configuration {
+      automatic_update_enabled          = true
+      provision_vm_agent_enabled        = true
+      provision_vm_config_agent_enabled = true
+      time_zone                         = "UTC"
+    }
+  }
+
+  storage_profile {
+    image_id                  = azurerm_stack_hci_marketplace_gallery_image.test.id
+    data_disk_ids             = [azurerm_stack_hci_virtual_hard_disk.test.id]
+    vm_config_storage_path_id = azurerm_stack_hci_storage_path.test.id
+  }
+
+  security_profile {
+    secure_boot_enabled = true
+    security_type       = "TrustedLaunch"
+    tpm_enabled         = true
+  }
+
+  http_proxy_configuration {
+    http_proxy  = "http://proxy.example.com:3128"
+    https_proxy = "http://proxy.example.com:3128"
+    no_proxy    = ["localhost", "127.0.0.1"]
+  }
+}
+`, template, os.Getenv(customLocationIdEnv), data.RandomString)
+}
+
+func (r StackHCIVirtualMachineInstanceResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine_instance" "import" {
+  arc_machine_id        = azurerm_stack_hci_virtual_machine_instance.test.arc_machine_id
+  custom_location_id    = azurerm_stack_hci_virtual_machine_instance.test.custom_location_id
+  network_interface_ids = azurerm_stack_hci_virtual_machine_instance.test.network_interface_ids
+
+  hardware_profile {
+    vm_size          = "Custom"
+    processor_number = 2
+    memory_in_mb     = 8192
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "!password!@#$"
+    computer_name  = "testvm"
+
+    windows_configuration {}
+  }
+
+  storage_profile {
+    image_id = azurerm_stack_hci_virtual_machine_instance.test.storage_profile.0.image_id
+  }
+}
+`, config)
+}
+
+func (r StackHCIVirtualMachineInstanceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-hci-vmi-%[2]s"
+  location = "%[1]s"
+}
+
+// service principal of 'Microsoft.AzureStackHCI Resource Provider'
+data "azuread_service_principal" "hciRp" {
+  client_id = "1412d89f-b8a8-4111-b4fd-e82905cbd85d"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Azure Connected Machine Resource Manager"
+  principal_id         = data.azuread_service_principal.hciRp.object_id
+}
+
+resource "azurerm_stack_hci_marketplace_gallery_image" "test" {
+  name                = "acctest-mgi-%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+  hyperv_generation   = "V2"
+  os_type             = "Windows"
+  version             = "20348.2582.240703"
+  identifier {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-azure-edition-core"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+  virtual_switch_name = "ConvergedSwitch(managementcompute)"
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-ni-%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+
+  lifecycle {
+    ignore_changes = [mac_address]
+  }
+}
+
+resource "azurerm_arc_machine" "test" {
+  name                = "acctest-hcivm-%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  kind                = "HCI"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.Locations.Primary, data.RandomString, os.Getenv(customLocationIdEnv))
+}
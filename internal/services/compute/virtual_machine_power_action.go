@@ -31,7 +31,7 @@ func newVirtualMachinePowerAction() action.Action {
 type VirtualMachinePowerActionModel struct {
 	VirtualMachineId types.String `tfsdk:"virtual_machine_id"`
 	Action           types.String `tfsdk:"power_action"`
-	// TODO - Custom timeouts?
+	Timeouts         types.Object `tfsdk:"timeouts"`
 }
 
 func (v *VirtualMachinePowerAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
@@ -61,6 +61,9 @@ func (v *VirtualMachinePowerAction) Schema(_ context.Context, _ action.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sdk.ActionTimeoutsBlock(),
+		},
 	}
 }
 
@@ -71,9 +74,6 @@ func (v *VirtualMachinePowerAction) Metadata(_ context.Context, _ action.Metadat
 func (v *VirtualMachinePowerAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
 	client := v.Client.Compute.VirtualMachinesClient
 
-	ctx, cancel := context.WithTimeout(ctx, time.Minute*15)
-	defer cancel()
-
 	model := VirtualMachinePowerActionModel{}
 
 	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
@@ -81,6 +81,15 @@ func (v *VirtualMachinePowerAction) Invoke(ctx context.Context, request action.I
 		return
 	}
 
+	timeout, diags := v.InvokeTimeout(ctx, model.Timeouts, time.Minute*15)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	id, err := virtualmachines.ParseVirtualMachineID(model.VirtualMachineId.ValueString())
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
@@ -95,18 +104,36 @@ func (v *VirtualMachinePowerAction) Invoke(ctx context.Context, request action.I
 
 	switch powerAction {
 	case "restart":
-		if err := client.RestartThenPoll(ctx, *id); err != nil {
+		result, err := client.Restart(ctx, *id)
+		if err != nil {
 			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+		if err := v.PollWithProgress(ctx, response, fmt.Sprintf("restarting %s", id.VirtualMachineName), result.Poller); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
 		}
 
 	case "power_on":
-		if err := client.StartThenPoll(ctx, *id); err != nil {
+		result, err := client.Start(ctx, *id)
+		if err != nil {
 			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting %s: %+v", id, err))
+			return
+		}
+		if err := v.PollWithProgress(ctx, response, fmt.Sprintf("starting %s", id.VirtualMachineName), result.Poller); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
 		}
 
 	case "power_off":
-		if err := client.PowerOffThenPoll(ctx, *id, virtualmachines.DefaultPowerOffOperationOptions()); err != nil {
+		result, err := client.PowerOff(ctx, *id, virtualmachines.DefaultPowerOffOperationOptions())
+		if err != nil {
 			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("stopping %s: %+v", id, err))
+			return
+		}
+		if err := v.PollWithProgress(ctx, response, fmt.Sprintf("stopping %s", id.VirtualMachineName), result.Poller); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
 		}
 	}
 
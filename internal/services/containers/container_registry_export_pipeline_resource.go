@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/exportpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ContainerRegistryExportPipelineResource{}
+
+type ContainerRegistryExportPipelineResource struct{}
+
+type ContainerRegistryExportPipelineModel struct {
+	Name                string                                     `tfschema:"name"`
+	ContainerRegistryId string                                     `tfschema:"container_registry_id"`
+	Location            string                                     `tfschema:"location"`
+	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Options             []string                                   `tfschema:"options"`
+	Target              []ContainerRegistryPipelineStorageModel    `tfschema:"target"`
+}
+
+// ContainerRegistryPipelineStorageModel is shared by the source of an Import Pipeline and the target of an Export Pipeline
+type ContainerRegistryPipelineStorageModel struct {
+	StorageContainerUri  string `tfschema:"storage_container_uri"`
+	KeyVaultSasSecretUri string `tfschema:"key_vault_sas_secret_uri"`
+}
+
+func (ContainerRegistryExportPipelineResource) ResourceType() string {
+	return "azurerm_container_registry_export_pipeline"
+}
+
+func (ContainerRegistryExportPipelineResource) ModelObject() interface{} {
+	return &ContainerRegistryExportPipelineModel{}
+}
+
+func (ContainerRegistryExportPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return exportpipelines.ValidateExportPipelineID
+}
+
+func (ContainerRegistryExportPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemOrUserAssignedIdentityRequiredForceNew(),
+
+		"target": containerRegistryPipelineStorageSchema(),
+
+		"options": containerRegistryPipelineOptionsSchema(exportpipelines.PossibleValuesForPipelineOptions()),
+	}
+}
+
+func (ContainerRegistryExportPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryExportPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.ExportPipelines
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ContainerRegistryExportPipelineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := exportpipelines.NewExportPipelineID(subscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := exportpipelines.ExportPipeline{
+				Identity: expandedIdentity,
+				Location: pointer.To(location.Normalize(config.Location)),
+				Properties: &exportpipelines.ExportPipelineProperties{
+					Target: exportpipelines.ExportPipelineTargetProperties{
+						KeyVaultUri: config.Target[0].KeyVaultSasSecretUri,
+						Type:        pointer.To(containerRegistryPipelineStorageTypeBlobContainer),
+						Uri:         pointer.To(config.Target[0].StorageContainerUri),
+					},
+				},
+			}
+
+			if len(config.Options) > 0 {
+				options := make([]exportpipelines.PipelineOptions, 0, len(config.Options))
+				for _, v := range config.Options {
+					options = append(options, exportpipelines.PipelineOptions(v))
+				}
+				payload.Properties.Options = &options
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryExportPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.ExportPipelines
+
+			id, err := exportpipelines.ParseExportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryExportPipelineModel{
+				Name:                id.ExportPipelineName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.Target = []ContainerRegistryPipelineStorageModel{
+						{
+							StorageContainerUri:  pointer.From(props.Target.Uri),
+							KeyVaultSasSecretUri: props.Target.KeyVaultUri,
+						},
+					}
+
+					options := make([]string, 0)
+					for _, v := range pointer.From(props.Options) {
+						options = append(options, string(v))
+					}
+					state.Options = options
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryExportPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.ExportPipelines
+
+			id, err := exportpipelines.ParseExportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// the API only supports Azure Storage blob containers as the source and target of a pipeline
+const containerRegistryPipelineStorageTypeBlobContainer = "AzureStorageBlobContainer"
+
+func containerRegistryPipelineStorageSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"storage_container_uri": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+
+				"key_vault_sas_secret_uri": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+				},
+			},
+		},
+	}
+}
+
+func containerRegistryPipelineOptionsSchema(possibleValues []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringInSlice(possibleValues, false),
+		},
+	}
+}
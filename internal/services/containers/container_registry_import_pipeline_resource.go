@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/importpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = ContainerRegistryImportPipelineResource{}
+
+type ContainerRegistryImportPipelineResource struct{}
+
+type ContainerRegistryImportPipelineModel struct {
+	Name                 string                                     `tfschema:"name"`
+	ContainerRegistryId  string                                     `tfschema:"container_registry_id"`
+	Location             string                                     `tfschema:"location"`
+	Identity             []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Options              []string                                   `tfschema:"options"`
+	Source               []ContainerRegistryPipelineStorageModel    `tfschema:"source"`
+	SourceTriggerEnabled bool                                       `tfschema:"source_trigger_enabled"`
+}
+
+func (ContainerRegistryImportPipelineResource) ResourceType() string {
+	return "azurerm_container_registry_import_pipeline"
+}
+
+func (ContainerRegistryImportPipelineResource) ModelObject() interface{} {
+	return &ContainerRegistryImportPipelineModel{}
+}
+
+func (ContainerRegistryImportPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return importpipelines.ValidateImportPipelineID
+}
+
+func (ContainerRegistryImportPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemOrUserAssignedIdentityRequiredForceNew(),
+
+		"source": containerRegistryPipelineStorageSchema(),
+
+		"options": containerRegistryPipelineOptionsSchema(importpipelines.PossibleValuesForPipelineOptions()),
+
+		"source_trigger_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+	}
+}
+
+func (ContainerRegistryImportPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryImportPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.ImportPipelines
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ContainerRegistryImportPipelineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := importpipelines.NewImportPipelineID(subscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(config.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			triggerStatus := importpipelines.TriggerStatusDisabled
+			if config.SourceTriggerEnabled {
+				triggerStatus = importpipelines.TriggerStatusEnabled
+			}
+
+			payload := importpipelines.ImportPipeline{
+				Identity: expandedIdentity,
+				Location: pointer.To(location.Normalize(config.Location)),
+				Properties: &importpipelines.ImportPipelineProperties{
+					Source: importpipelines.ImportPipelineSourceProperties{
+						KeyVaultUri: config.Source[0].KeyVaultSasSecretUri,
+						Type:        pointer.To(importpipelines.PipelineSourceTypeAzureStorageBlobContainer),
+						Uri:         pointer.To(config.Source[0].StorageContainerUri),
+					},
+					Trigger: &importpipelines.PipelineTriggerProperties{
+						SourceTrigger: &importpipelines.PipelineSourceTriggerProperties{
+							Status: triggerStatus,
+						},
+					},
+				},
+			}
+
+			if len(config.Options) > 0 {
+				options := make([]importpipelines.PipelineOptions, 0, len(config.Options))
+				for _, v := range config.Options {
+					options = append(options, importpipelines.PipelineOptions(v))
+				}
+				payload.Properties.Options = &options
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryImportPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.ImportPipelines
+
+			id, err := importpipelines.ParseImportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryImportPipelineModel{
+				Name:                id.ImportPipelineName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.Source = []ContainerRegistryPipelineStorageModel{
+						{
+							StorageContainerUri:  pointer.From(props.Source.Uri),
+							KeyVaultSasSecretUri: props.Source.KeyVaultUri,
+						},
+					}
+
+					options := make([]string, 0)
+					for _, v := range pointer.From(props.Options) {
+						options = append(options, string(v))
+					}
+					state.Options = options
+
+					// the service enables the source trigger when it's omitted
+					state.SourceTriggerEnabled = true
+					if props.Trigger != nil && props.Trigger.SourceTrigger != nil {
+						state.SourceTriggerEnabled = props.Trigger.SourceTrigger.Status == importpipelines.TriggerStatusEnabled
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryImportPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.ImportPipelines
+
+			id, err := importpipelines.ParseImportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
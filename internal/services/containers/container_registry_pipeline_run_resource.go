@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/exportpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/importpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = ContainerRegistryPipelineRunResource{}
+	_ sdk.ResourceWithUpdate = ContainerRegistryPipelineRunResource{}
+)
+
+type ContainerRegistryPipelineRunResource struct{}
+
+type ContainerRegistryPipelineRunModel struct {
+	Name                string   `tfschema:"name"`
+	ContainerRegistryId string   `tfschema:"container_registry_id"`
+	PipelineId          string   `tfschema:"pipeline_id"`
+	Artifacts           []string `tfschema:"artifacts"`
+	CatalogDigest       string   `tfschema:"catalog_digest"`
+	ForceUpdateTag      string   `tfschema:"force_update_tag"`
+	SourceBlobName      string   `tfschema:"source_blob_name"`
+	TargetBlobName      string   `tfschema:"target_blob_name"`
+	ErrorMessage        string   `tfschema:"error_message"`
+	FinishTime          string   `tfschema:"finish_time"`
+	ImportedArtifacts   []string `tfschema:"imported_artifacts"`
+	ProgressPercentage  string   `tfschema:"progress_percentage"`
+	StartTime           string   `tfschema:"start_time"`
+	Status              string   `tfschema:"status"`
+}
+
+func (ContainerRegistryPipelineRunResource) ResourceType() string {
+	return "azurerm_container_registry_pipeline_run"
+}
+
+func (ContainerRegistryPipelineRunResource) ModelObject() interface{} {
+	return &ContainerRegistryPipelineRunModel{}
+}
+
+func (ContainerRegistryPipelineRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pipelineruns.ValidatePipelineRunID
+}
+
+func (ContainerRegistryPipelineRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"pipeline_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				exportpipelines.ValidateExportPipelineID,
+				importpipelines.ValidateImportPipelineID,
+			),
+		},
+
+		"artifacts": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ConflictsWith: []string{"source_blob_name"},
+		},
+
+		"catalog_digest": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"force_update_tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"source_blob_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"artifacts", "target_blob_name"},
+		},
+
+		"target_blob_name": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"source_blob_name"},
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"finish_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"imported_artifacts": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"progress_percentage": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerRegistryPipelineRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.PipelineRuns
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ContainerRegistryPipelineRunModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := pipelineruns.NewPipelineRunID(subscriptionId, registryId.ResourceGroupName, registryId.RegistryName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			request, err := expandContainerRegistryPipelineRunRequest(config)
+			if err != nil {
+				return err
+			}
+
+			payload := pipelineruns.PipelineRun{
+				Properties: &pipelineruns.PipelineRunProperties{
+					Request: request,
+				},
+			}
+
+			if config.ForceUpdateTag != "" {
+				payload.Properties.ForceUpdateTag = pointer.To(config.ForceUpdateTag)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryPipelineRunModel{
+				Name:                id.PipelineRunName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ForceUpdateTag = pointer.From(props.ForceUpdateTag)
+
+					if request := props.Request; request != nil {
+						pipelineId, err := flattenContainerRegistryPipelineRunPipelineId(pointer.From(request.PipelineResourceId))
+						if err != nil {
+							return err
+						}
+						state.PipelineId = pipelineId
+						state.Artifacts = pointer.From(request.Artifacts)
+						state.CatalogDigest = pointer.From(request.CatalogDigest)
+
+						if request.Source != nil {
+							state.SourceBlobName = pointer.From(request.Source.Name)
+						}
+						if request.Target != nil {
+							state.TargetBlobName = pointer.From(request.Target.Name)
+						}
+					}
+
+					if runResponse := props.Response; runResponse != nil {
+						state.ErrorMessage = pointer.From(runResponse.PipelineRunErrorMessage)
+						state.FinishTime = pointer.From(runResponse.FinishTime)
+						state.ImportedArtifacts = pointer.From(runResponse.ImportedArtifacts)
+						state.StartTime = pointer.From(runResponse.StartTime)
+						state.Status = pointer.From(runResponse.Status)
+
+						if runResponse.Progress != nil {
+							state.ProgressPercentage = pointer.From(runResponse.Progress.Percentage)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerRegistryPipelineRunModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			// changing `force_update_tag` re-runs the pipeline with the existing request
+			payload := *existing.Model
+			payload.Properties.ForceUpdateTag = pointer.To(config.ForceUpdateTag)
+			payload.Properties.Response = nil
+
+			if err := client.CreateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ContainerRegistryPipelineRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryPipelineRunRequest(input ContainerRegistryPipelineRunModel) (*pipelineruns.PipelineRunRequest, error) {
+	output := &pipelineruns.PipelineRunRequest{
+		PipelineResourceId: pointer.To(input.PipelineId),
+	}
+
+	if input.CatalogDigest != "" {
+		output.CatalogDigest = pointer.To(input.CatalogDigest)
+	}
+
+	if _, err := exportpipelines.ParseExportPipelineID(input.PipelineId); err == nil {
+		if len(input.Artifacts) == 0 || input.TargetBlobName == "" {
+			return nil, fmt.Errorf("`artifacts` and `target_blob_name` must be specified when `pipeline_id` is an Export Pipeline")
+		}
+
+		output.Artifacts = pointer.To(input.Artifacts)
+		output.Target = &pipelineruns.PipelineRunTargetProperties{
+			Name: pointer.To(input.TargetBlobName),
+			Type: pointer.To(pipelineruns.PipelineRunTargetTypeAzureStorageBlob),
+		}
+
+		return output, nil
+	}
+
+	if input.SourceBlobName == "" {
+		return nil, fmt.Errorf("`source_blob_name` must be specified when `pipeline_id` is an Import Pipeline")
+	}
+
+	output.Source = &pipelineruns.PipelineRunSourceProperties{
+		Name: pointer.To(input.SourceBlobName),
+		Type: pointer.To(pipelineruns.PipelineRunSourceTypeAzureStorageBlob),
+	}
+
+	return output, nil
+}
+
+func flattenContainerRegistryPipelineRunPipelineId(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	if exportPipelineId, err := exportpipelines.ParseExportPipelineIDInsensitively(input); err == nil {
+		return exportPipelineId.ID(), nil
+	}
+
+	importPipelineId, err := importpipelines.ParseImportPipelineIDInsensitively(input)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q as an Import or Export Pipeline ID: %+v", input, err)
+	}
+
+	return importPipelineId.ID(), nil
+}
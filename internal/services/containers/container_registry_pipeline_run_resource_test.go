@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryPipelineRunResource struct {
+	sourceRegistryId string
+	artifact         string
+}
+
+func preCheckContainerRegistryPipelineRun(t *testing.T) ContainerRegistryPipelineRunResource {
+	// - ARM_TEST_ACR_PIPELINE_RUN_SOURCE_REGISTRY_ID represents an existing Premium registry to export from
+	// - ARM_TEST_ACR_PIPELINE_RUN_ARTIFACT represents an artifact within that registry, e.g. `hello-world:latest`
+	variables := []string{
+		"ARM_TEST_ACR_PIPELINE_RUN_SOURCE_REGISTRY_ID",
+		"ARM_TEST_ACR_PIPELINE_RUN_ARTIFACT",
+	}
+
+	for _, variable := range variables {
+		value := os.Getenv(variable)
+		if value == "" {
+			t.Skipf("`%s` must be set for acceptance tests!", variable)
+		}
+	}
+
+	return ContainerRegistryPipelineRunResource{
+		sourceRegistryId: os.Getenv("ARM_TEST_ACR_PIPELINE_RUN_SOURCE_REGISTRY_ID"),
+		artifact:         os.Getenv("ARM_TEST_ACR_PIPELINE_RUN_ARTIFACT"),
+	}
+}
+
+func TestAccContainerRegistryPipelineRun_export(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "export")
+	r := preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryPipelineRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "export")
+	r := preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryPipelineRun_transfer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "import")
+	r := preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.transfer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("imported_artifacts.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryPipelineRun_forceUpdateTag(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "export")
+	r := preCheckContainerRegistryPipelineRun(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.forceUpdateTag(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryPipelineRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pipelineruns.ParsePipelineRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient.PipelineRuns.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryPipelineRunResource) export(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_pipeline_run" "export" {
+  name                  = "acctestexportrun%d"
+  container_registry_id = data.azurerm_container_registry.source.id
+  pipeline_id           = azurerm_container_registry_export_pipeline.test.id
+  artifacts             = ["%s"]
+  target_blob_name      = "acctest%d"
+}
+`, r.template(data), data.RandomInteger, r.artifact, data.RandomInteger)
+}
+
+func (r ContainerRegistryPipelineRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_pipeline_run" "import" {
+  name                  = azurerm_container_registry_pipeline_run.export.name
+  container_registry_id = azurerm_container_registry_pipeline_run.export.container_registry_id
+  pipeline_id           = azurerm_container_registry_pipeline_run.export.pipeline_id
+  artifacts             = azurerm_container_registry_pipeline_run.export.artifacts
+  target_blob_name      = azurerm_container_registry_pipeline_run.export.target_blob_name
+}
+`, r.export(data))
+}
+
+func (r ContainerRegistryPipelineRunResource) forceUpdateTag(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_pipeline_run" "export" {
+  name                  = "acctestexportrun%d"
+  container_registry_id = data.azurerm_container_registry.source.id
+  pipeline_id           = azurerm_container_registry_export_pipeline.test.id
+  artifacts             = ["%s"]
+  target_blob_name      = "acctest%d"
+  force_update_tag      = "rerun"
+}
+`, r.template(data), data.RandomInteger, r.artifact, data.RandomInteger)
+}
+
+func (r ContainerRegistryPipelineRunResource) transfer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "target" {
+  name                = "acctestacr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+
+resource "azurerm_container_registry_import_pipeline" "test" {
+  name                   = "acctestimport%d"
+  container_registry_id  = azurerm_container_registry.target.id
+  location               = azurerm_container_registry.target.location
+  source_trigger_enabled = false
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  source {
+    storage_container_uri    = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+    key_vault_sas_secret_uri = azurerm_key_vault_secret.test.versionless_id
+  }
+}
+
+resource "azurerm_container_registry_pipeline_run" "import" {
+  name                  = "acctestimportrun%d"
+  container_registry_id = azurerm_container_registry.target.id
+  pipeline_id           = azurerm_container_registry_import_pipeline.test.id
+  source_blob_name      = azurerm_container_registry_pipeline_run.export.target_blob_name
+}
+`, r.export(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ContainerRegistryPipelineRunResource) template(data acceptance.TestData) string {
+	sourceRegistryName, sourceResourceGroupName := "", ""
+	if id, err := registries.ParseRegistryID(r.sourceRegistryId); err == nil {
+		sourceRegistryName = id.RegistryName
+		sourceResourceGroupName = id.ResourceGroupName
+	}
+
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_container_registry" "source" {
+  name                = "%s"
+  resource_group_name = "%s"
+}
+
+resource "azurerm_container_registry_export_pipeline" "test" {
+  name                  = "acctestexport%d"
+  container_registry_id = data.azurerm_container_registry.source.id
+  location              = data.azurerm_container_registry.source.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  target {
+    storage_container_uri    = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+    key_vault_sas_secret_uri = azurerm_key_vault_secret.test.versionless_id
+  }
+}
+`, containerRegistryPipelineStorageTemplate(data), sourceRegistryName, sourceResourceGroupName, data.RandomInteger)
+}
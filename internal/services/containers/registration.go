@@ -74,6 +74,9 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerRegistryCacheRule{},
 		ContainerRegistryTaskResource{},
 		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryExportPipelineResource{},
+		ContainerRegistryImportPipelineResource{},
+		ContainerRegistryPipelineRunResource{},
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		KubernetesClusterExtensionResource{},
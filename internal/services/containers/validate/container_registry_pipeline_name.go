@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ContainerRegistryPipelineName validates the name of an Import Pipeline, Export Pipeline or Pipeline Run
+func ContainerRegistryPipelineName(v interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]{5,50}$`), fmt.Sprintf("only alpha numeric characters in length of 5 to 50 are allowed in %q", k))(v, k)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/customizationtasks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = DevCenterCatalogCustomizationTaskDataSource{}
+
+type DevCenterCatalogCustomizationTaskDataSource struct{}
+
+type DevCenterCatalogCustomizationTaskDataSourceModel struct {
+	Name               string                                   `tfschema:"name"`
+	DevCenterCatalogId string                                   `tfschema:"dev_center_catalog_id"`
+	Input              []DevCenterCatalogCustomizationTaskInput `tfschema:"input"`
+	TimeoutInSeconds   int64                                    `tfschema:"timeout_in_seconds"`
+	ValidationStatus   string                                   `tfschema:"validation_status"`
+}
+
+type DevCenterCatalogCustomizationTaskInput struct {
+	Name        string `tfschema:"name"`
+	Description string `tfschema:"description"`
+	Required    bool   `tfschema:"required"`
+	Type        string `tfschema:"type"`
+}
+
+func (DevCenterCatalogCustomizationTaskDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_catalog_id": commonschema.ResourceIDReferenceRequired(&customizationtasks.DevCenterCatalogId{}),
+	}
+}
+
+func (DevCenterCatalogCustomizationTaskDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"input": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"required": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"timeout_in_seconds": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"validation_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (DevCenterCatalogCustomizationTaskDataSource) ModelObject() interface{} {
+	return &DevCenterCatalogCustomizationTaskDataSourceModel{}
+}
+
+func (DevCenterCatalogCustomizationTaskDataSource) ResourceType() string {
+	return "azurerm_dev_center_catalog_customization_task"
+}
+
+func (DevCenterCatalogCustomizationTaskDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.CustomizationTasks
+
+			var state DevCenterCatalogCustomizationTaskDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			catalogId, err := customizationtasks.ParseDevCenterCatalogID(state.DevCenterCatalogId)
+			if err != nil {
+				return err
+			}
+
+			id := customizationtasks.NewTaskID(catalogId.SubscriptionId, catalogId.ResourceGroupName, catalogId.DevCenterName, catalogId.CatalogName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Input = flattenDevCenterCatalogCustomizationTaskInputs(props.Inputs)
+					state.TimeoutInSeconds = pointer.From(props.Timeout)
+					state.ValidationStatus = string(pointer.From(props.ValidationStatus))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenDevCenterCatalogCustomizationTaskInputs(input *map[string]customizationtasks.CustomizationTaskInput) []DevCenterCatalogCustomizationTaskInput {
+	result := make([]DevCenterCatalogCustomizationTaskInput, 0)
+	if input == nil {
+		return result
+	}
+
+	for name, v := range *input {
+		result = append(result, DevCenterCatalogCustomizationTaskInput{
+			Name:        name,
+			Description: pointer.From(v.Description),
+			Required:    pointer.From(v.Required),
+			Type:        string(pointer.From(v.Type)),
+		})
+	}
+
+	// the inputs are returned as a map, so sort them to keep the ordering stable
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
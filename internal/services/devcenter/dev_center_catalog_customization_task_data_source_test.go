@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterCatalogCustomizationTaskDataSource struct{}
+
+// customization tasks are sourced from the contents of a catalog, so these tests require an existing
+// dev center catalog which contains a customization task
+const (
+	devCenterCatalogIdEnv             = "ARM_TEST_DEV_CENTER_CATALOG_ID"
+	devCenterCustomizationTaskNameEnv = "ARM_TEST_DEV_CENTER_CUSTOMIZATION_TASK_NAME"
+)
+
+func TestAccDevCenterCatalogCustomizationTaskDataSource_basic(t *testing.T) {
+	if os.Getenv(devCenterCatalogIdEnv) == "" || os.Getenv(devCenterCustomizationTaskNameEnv) == "" {
+		t.Skipf("skipping since %q and %q have not been specified", devCenterCatalogIdEnv, devCenterCustomizationTaskNameEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_catalog_customization_task", "test")
+	d := DevCenterCatalogCustomizationTaskDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("input.#").Exists(),
+				check.That(data.ResourceName).Key("validation_status").Exists(),
+			),
+		},
+	})
+}
+
+func (d DevCenterCatalogCustomizationTaskDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_dev_center_catalog_customization_task" "test" {
+  name                  = "%s"
+  dev_center_catalog_id = "%s"
+}
+`, os.Getenv(devCenterCustomizationTaskNameEnv), os.Getenv(devCenterCatalogIdEnv))
+}
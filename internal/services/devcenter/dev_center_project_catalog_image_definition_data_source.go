@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/imagedefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = DevCenterProjectCatalogImageDefinitionDataSource{}
+
+type DevCenterProjectCatalogImageDefinitionDataSource struct{}
+
+type DevCenterProjectCatalogImageDefinitionDataSourceModel struct {
+	Name                      string                                     `tfschema:"name"`
+	DevCenterProjectCatalogId string                                     `tfschema:"dev_center_project_catalog_id"`
+	ActiveImageReference      []DevCenterImageReferenceModel             `tfschema:"active_image_reference"`
+	AutoImageBuildEnabled     bool                                       `tfschema:"auto_image_build_enabled"`
+	FileUrl                   string                                     `tfschema:"file_url"`
+	ImageReference            []DevCenterImageReferenceModel             `tfschema:"image_reference"`
+	ImageValidationStatus     string                                     `tfschema:"image_validation_status"`
+	LatestBuild               []DevCenterImageDefinitionLatestBuildModel `tfschema:"latest_build"`
+	ValidationStatus          string                                     `tfschema:"validation_status"`
+}
+
+type DevCenterImageReferenceModel struct {
+	Id           string `tfschema:"id"`
+	ExactVersion string `tfschema:"exact_version"`
+}
+
+type DevCenterImageDefinitionLatestBuildModel struct {
+	Name      string `tfschema:"name"`
+	Status    string `tfschema:"status"`
+	StartTime string `tfschema:"start_time"`
+	EndTime   string `tfschema:"end_time"`
+}
+
+func (DevCenterProjectCatalogImageDefinitionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_project_catalog_id": commonschema.ResourceIDReferenceRequired(&imagedefinitions.CatalogId{}),
+	}
+}
+
+func (DevCenterProjectCatalogImageDefinitionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"active_image_reference": devCenterImageReferenceSchemaComputed(),
+
+		"auto_image_build_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"file_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image_reference": devCenterImageReferenceSchemaComputed(),
+
+		"image_validation_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_build": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"validation_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (DevCenterProjectCatalogImageDefinitionDataSource) ModelObject() interface{} {
+	return &DevCenterProjectCatalogImageDefinitionDataSourceModel{}
+}
+
+func (DevCenterProjectCatalogImageDefinitionDataSource) ResourceType() string {
+	return "azurerm_dev_center_project_catalog_image_definition"
+}
+
+func (DevCenterProjectCatalogImageDefinitionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ImageDefinitions
+
+			var state DevCenterProjectCatalogImageDefinitionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			catalogId, err := imagedefinitions.ParseCatalogID(state.DevCenterProjectCatalogId)
+			if err != nil {
+				return err
+			}
+
+			id := imagedefinitions.NewImageDefinitionID(catalogId.SubscriptionId, catalogId.ResourceGroupName, catalogId.ProjectName, catalogId.CatalogName, state.Name)
+
+			resp, err := client.ProjectCatalogImageDefinitionsGetByProjectCatalog(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ActiveImageReference = flattenDevCenterImageReference(props.ActiveImageReference)
+					state.AutoImageBuildEnabled = pointer.From(props.AutoImageBuild) == imagedefinitions.AutoImageBuildStatusEnabled
+					state.FileUrl = pointer.From(props.FileURL)
+					state.ImageReference = flattenDevCenterImageReference(props.ImageReference)
+					state.ImageValidationStatus = string(pointer.From(props.ImageValidationStatus))
+					state.LatestBuild = flattenDevCenterImageDefinitionLatestBuild(props.LatestBuild)
+					state.ValidationStatus = string(pointer.From(props.ValidationStatus))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func devCenterImageReferenceSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"exact_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenDevCenterImageReference(input *imagedefinitions.ImageReference) []DevCenterImageReferenceModel {
+	if input == nil {
+		return make([]DevCenterImageReferenceModel, 0)
+	}
+
+	return []DevCenterImageReferenceModel{
+		{
+			Id:           pointer.From(input.Id),
+			ExactVersion: pointer.From(input.ExactVersion),
+		},
+	}
+}
+
+func flattenDevCenterImageDefinitionLatestBuild(input *imagedefinitions.LatestImageBuild) []DevCenterImageDefinitionLatestBuildModel {
+	if input == nil {
+		return make([]DevCenterImageDefinitionLatestBuildModel, 0)
+	}
+
+	return []DevCenterImageDefinitionLatestBuildModel{
+		{
+			Name:      pointer.From(input.Name),
+			Status:    string(pointer.From(input.Status)),
+			StartTime: pointer.From(input.StartTime),
+			EndTime:   pointer.From(input.EndTime),
+		},
+	}
+}
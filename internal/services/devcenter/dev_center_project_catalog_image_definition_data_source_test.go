@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DevCenterProjectCatalogImageDefinitionDataSource struct{}
+
+// image definitions are sourced from the contents of a project catalog, so these tests require an existing
+// project catalog which contains an image definition
+const (
+	devCenterProjectCatalogIdEnv    = "ARM_TEST_DEV_CENTER_PROJECT_CATALOG_ID"
+	devCenterImageDefinitionNameEnv = "ARM_TEST_DEV_CENTER_IMAGE_DEFINITION_NAME"
+)
+
+func TestAccDevCenterProjectCatalogImageDefinitionDataSource_basic(t *testing.T) {
+	if os.Getenv(devCenterProjectCatalogIdEnv) == "" || os.Getenv(devCenterImageDefinitionNameEnv) == "" {
+		t.Skipf("skipping since %q and %q have not been specified", devCenterProjectCatalogIdEnv, devCenterImageDefinitionNameEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_dev_center_project_catalog_image_definition", "test")
+	d := DevCenterProjectCatalogImageDefinitionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("file_url").Exists(),
+				check.That(data.ResourceName).Key("image_reference.#").HasValue("1"),
+				check.That(data.ResourceName).Key("validation_status").Exists(),
+			),
+		},
+	})
+}
+
+func (d DevCenterProjectCatalogImageDefinitionDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_dev_center_project_catalog_image_definition" "test" {
+  name                          = "%s"
+  dev_center_project_catalog_id = "%s"
+}
+`, os.Getenv(devCenterImageDefinitionNameEnv), os.Getenv(devCenterProjectCatalogIdEnv))
+}
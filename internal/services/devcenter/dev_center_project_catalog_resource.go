@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projectcatalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = DevCenterProjectCatalogResource{}
+	_ sdk.ResourceWithUpdate = DevCenterProjectCatalogResource{}
+)
+
+type DevCenterProjectCatalogResource struct{}
+
+func (r DevCenterProjectCatalogResource) ModelObject() interface{} {
+	return &DevCenterProjectCatalogResourceModel{}
+}
+
+type DevCenterProjectCatalogResourceModel struct {
+	Name               string                   `tfschema:"name"`
+	DevCenterProjectId string                   `tfschema:"dev_center_project_id"`
+	CatalogGitHub      []CatalogPropertiesModel `tfschema:"catalog_github"`
+	CatalogAdoGit      []CatalogPropertiesModel `tfschema:"catalog_adogit"`
+	SyncType           string                   `tfschema:"sync_type"`
+	Tags               map[string]string        `tfschema:"tags"`
+}
+
+func (r DevCenterProjectCatalogResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return projectcatalogs.ValidateCatalogID
+}
+
+func (r DevCenterProjectCatalogResource) ResourceType() string {
+	return "azurerm_dev_center_project_catalog"
+}
+
+func (r DevCenterProjectCatalogResource) Arguments() map[string]*pluginsdk.Schema {
+	catalogGitHub := CatalogPropertiesSchema()
+	catalogGitHub.ExactlyOneOf = []string{"catalog_github", "catalog_adogit"}
+
+	catalogAdoGit := CatalogPropertiesSchema()
+	catalogAdoGit.ExactlyOneOf = []string{"catalog_github", "catalog_adogit"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dev_center_project_id": commonschema.ResourceIDReferenceRequiredForceNew(&projectcatalogs.ProjectId{}),
+
+		"catalog_github": catalogGitHub,
+
+		"catalog_adogit": catalogAdoGit,
+
+		"sync_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(projectcatalogs.CatalogSyncTypeManual),
+			ValidateFunc: validation.StringInSlice(projectcatalogs.PossibleValuesForCatalogSyncType(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectCatalogResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectCatalogResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectCatalogs
+
+			var model DevCenterProjectCatalogResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := projectcatalogs.ParseProjectID(model.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := projectcatalogs.NewCatalogID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.ProjectName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := projectcatalogs.Catalog{
+				Properties: &projectcatalogs.CatalogProperties{
+					AdoGit:   expandDevCenterProjectCatalogProperties(model.CatalogAdoGit),
+					GitHub:   expandDevCenterProjectCatalogProperties(model.CatalogGitHub),
+					SyncType: pointer.To(projectcatalogs.CatalogSyncType(model.SyncType)),
+					Tags:     pointer.To(model.Tags),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectCatalogResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectCatalogs
+
+			id, err := projectcatalogs.ParseCatalogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectCatalogResourceModel{
+				Name:               id.CatalogName,
+				DevCenterProjectId: projectcatalogs.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.CatalogAdoGit = flattenDevCenterProjectCatalogProperties(props.AdoGit)
+					state.CatalogGitHub = flattenDevCenterProjectCatalogProperties(props.GitHub)
+					state.SyncType = string(pointer.From(props.SyncType))
+					state.Tags = pointer.From(props.Tags)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectCatalogResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectCatalogs
+
+			id, err := projectcatalogs.ParseCatalogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectCatalogResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := projectcatalogs.CatalogUpdate{
+				Properties: &projectcatalogs.CatalogUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("catalog_github") {
+				parameters.Properties.GitHub = expandDevCenterProjectCatalogProperties(model.CatalogGitHub)
+			}
+
+			if metadata.ResourceData.HasChange("catalog_adogit") {
+				parameters.Properties.AdoGit = expandDevCenterProjectCatalogProperties(model.CatalogAdoGit)
+			}
+
+			if metadata.ResourceData.HasChange("sync_type") {
+				parameters.Properties.SyncType = pointer.To(projectcatalogs.CatalogSyncType(model.SyncType))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Properties.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.PatchThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectCatalogResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20250201.ProjectCatalogs
+
+			id, err := projectcatalogs.ParseCatalogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectCatalogProperties(input []CatalogPropertiesModel) *projectcatalogs.GitCatalog {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &projectcatalogs.GitCatalog{
+		Uri:              pointer.To(input[0].URI),
+		Branch:           pointer.To(input[0].Branch),
+		SecretIdentifier: pointer.To(input[0].KeyVaultKeyUrl),
+		Path:             pointer.To(input[0].Path),
+	}
+}
+
+func flattenDevCenterProjectCatalogProperties(input *projectcatalogs.GitCatalog) []CatalogPropertiesModel {
+	if input == nil {
+		return make([]CatalogPropertiesModel, 0)
+	}
+
+	return []CatalogPropertiesModel{
+		{
+			URI:            pointer.From(input.Uri),
+			Branch:         pointer.From(input.Branch),
+			KeyVaultKeyUrl: pointer.From(input.SecretIdentifier),
+			Path:           pointer.From(input.Path),
+		},
+	}
+}
@@ -28,12 +28,14 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		DevCenterAttachedNetworkDataSource{},
+		DevCenterCatalogCustomizationTaskDataSource{},
 		DevCenterCatalogDataSource{},
 		DevCenterDataSource{},
 		DevCenterDevBoxDefinitionDataSource{},
 		DevCenterEnvironmentTypeDataSource{},
 		DevCenterGalleryDataSource{},
 		DevCenterNetworkConnectionDataSource{},
+		DevCenterProjectCatalogImageDefinitionDataSource{},
 		DevCenterProjectDataSource{},
 		DevCenterProjectEnvironmentTypeDataSource{},
 		DevCenterProjectPoolDataSource{},
@@ -48,7 +50,10 @@ func (r Registration) Resources() []sdk.Resource {
 		DevCenterDevBoxDefinitionResource{},
 		DevCenterEnvironmentTypeResource{},
 		DevCenterNetworkConnectionResource{},
+		DevCenterProjectCatalogResource{},
 		DevCenterProjectPoolResource{},
+		DevCenterProjectPoolScheduleResource{},
+		DevCenterProjectPolicyResource{},
 		DevCenterProjectResource{},
 		DevCenterProjectEnvironmentTypeResource{},
 		DevCenterResource{},
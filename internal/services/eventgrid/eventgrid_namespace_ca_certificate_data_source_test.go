@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type EventGridNamespaceCaCertificateDataSource struct{}
+
+func TestAccEventGridNamespaceCaCertificateDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("encoded_certificate").Exists(),
+				check.That(data.ResourceName).Key("description").HasValue("Fleet CA"),
+				check.That(data.ResourceName).Key("expiry_time").Exists(),
+				check.That(data.ResourceName).Key("issue_time").Exists(),
+			),
+		},
+	})
+}
+
+func (EventGridNamespaceCaCertificateDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name         = azurerm_eventgrid_namespace_ca_certificate.test.name
+  namespace_id = azurerm_eventgrid_namespace_ca_certificate.test.namespace_id
+}
+`, EventGridNamespaceCaCertificateResource{}.complete(data))
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type EventGridNamespaceClientDataSource struct{}
+
+func TestAccEventGridNamespaceClientDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventgrid_namespace_client", "test")
+	r := EventGridNamespaceClientDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("authentication_name").HasValue("sensor-01"),
+				check.That(data.ResourceName).Key("description").HasValue("Temperature sensor"),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("attributes.type").HasValue("sensor"),
+				check.That(data.ResourceName).Key("client_certificate_authentication.0.validation_scheme").HasValue("ThumbprintMatch"),
+			),
+		},
+	})
+}
+
+func (EventGridNamespaceClientDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventgrid_namespace_client" "test" {
+  name         = azurerm_eventgrid_namespace_client.test.name
+  namespace_id = azurerm_eventgrid_namespace_client.test.namespace_id
+}
+`, EventGridNamespaceClientResource{}.complete(data))
+}
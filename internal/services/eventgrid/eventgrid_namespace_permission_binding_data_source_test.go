@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type EventGridNamespacePermissionBindingDataSource struct{}
+
+func TestAccEventGridNamespacePermissionBindingDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("client_group_name").Exists(),
+				check.That(data.ResourceName).Key("topic_space_name").Exists(),
+				check.That(data.ResourceName).Key("permission").HasValue("Subscriber"),
+			),
+		},
+	})
+}
+
+func (EventGridNamespacePermissionBindingDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name         = azurerm_eventgrid_namespace_permission_binding.test.name
+  namespace_id = azurerm_eventgrid_namespace_permission_binding.test.namespace_id
+}
+`, EventGridNamespacePermissionBindingResource{}.complete(data))
+}
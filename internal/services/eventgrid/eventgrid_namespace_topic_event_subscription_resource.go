@@ -0,0 +1,1012 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/namespacetopics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceTopicEventSubscriptionResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceTopicEventSubscriptionResource{}
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+type EventGridNamespaceTopicEventSubscriptionResourceModel struct {
+	Name                string                                          `tfschema:"name"`
+	NamespaceTopicId    string                                          `tfschema:"namespace_topic_id"`
+	AdvancedFilter      []NamespaceTopicEventSubscriptionAdvancedFilter `tfschema:"advanced_filter"`
+	EventDeliverySchema string                                          `tfschema:"event_delivery_schema"`
+	ExpirationTimeUtc   string                                          `tfschema:"expiration_time_utc"`
+	IncludedEventTypes  []string                                        `tfschema:"included_event_types"`
+	PushDelivery        []NamespaceTopicEventSubscriptionPushDelivery   `tfschema:"push_delivery"`
+	QueueDelivery       []NamespaceTopicEventSubscriptionQueueDelivery  `tfschema:"queue_delivery"`
+}
+
+type NamespaceTopicEventSubscriptionAdvancedFilter struct {
+	OperatorType string   `tfschema:"operator_type"`
+	Key          string   `tfschema:"key"`
+	Value        string   `tfschema:"value"`
+	Values       []string `tfschema:"values"`
+}
+
+type NamespaceTopicEventSubscriptionQueueDelivery struct {
+	DeadLetterDestination        []NamespaceTopicEventSubscriptionDeadLetterDestination `tfschema:"dead_letter_destination"`
+	EventTimeToLive              string                                                 `tfschema:"event_time_to_live"`
+	MaxDeliveryCount             int64                                                  `tfschema:"max_delivery_count"`
+	ReceiveLockDurationInSeconds int64                                                  `tfschema:"receive_lock_duration_in_seconds"`
+}
+
+type NamespaceTopicEventSubscriptionPushDelivery struct {
+	DeadLetterDestination []NamespaceTopicEventSubscriptionDeadLetterDestination `tfschema:"dead_letter_destination"`
+	DeliveryIdentity      []NamespaceTopicEventSubscriptionIdentity              `tfschema:"delivery_identity"`
+	EventHubId            string                                                 `tfschema:"event_hub_id"`
+	EventTimeToLive       string                                                 `tfschema:"event_time_to_live"`
+	MaxDeliveryCount      int64                                                  `tfschema:"max_delivery_count"`
+	Webhook               []NamespaceTopicEventSubscriptionWebhook               `tfschema:"webhook"`
+}
+
+type NamespaceTopicEventSubscriptionWebhook struct {
+	Url                           string `tfschema:"url"`
+	ActiveDirectoryAppIdOrUri     string `tfschema:"active_directory_app_id_or_uri"`
+	ActiveDirectoryTenantId       string `tfschema:"active_directory_tenant_id"`
+	MaxEventsPerBatch             int64  `tfschema:"max_events_per_batch"`
+	PreferredBatchSizeInKilobytes int64  `tfschema:"preferred_batch_size_in_kilobytes"`
+}
+
+type NamespaceTopicEventSubscriptionDeadLetterDestination struct {
+	StorageAccountId         string                                    `tfschema:"storage_account_id"`
+	StorageBlobContainerName string                                    `tfschema:"storage_blob_container_name"`
+	Identity                 []NamespaceTopicEventSubscriptionIdentity `tfschema:"identity"`
+}
+
+type NamespaceTopicEventSubscriptionIdentity struct {
+	Type                   string `tfschema:"type"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9-]{3,50}$`),
+				"`name` must be between 3 and 50 characters long and can contain only letters, numbers and hyphens",
+			),
+		},
+
+		"namespace_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespacetopics.ValidateNamespaceTopicID,
+		},
+
+		"advanced_filter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 25,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"operator_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(namespaceTopicEventSubscriptionFilterOperatorTypes(), false),
+					},
+
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"values": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 25,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"event_delivery_schema": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(eventsubscriptions.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForDeliverySchema(), false),
+		},
+
+		"expiration_time_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"included_event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"push_delivery": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"push_delivery", "queue_delivery"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dead_letter_destination": namespaceTopicEventSubscriptionDeadLetterDestinationSchema(),
+
+					"delivery_identity": namespaceTopicEventSubscriptionIdentitySchema(),
+
+					"event_hub_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ExactlyOneOf: []string{"push_delivery.0.event_hub_id", "push_delivery.0.webhook"},
+						ValidateFunc: eventhubs.ValidateEventhubID,
+					},
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"webhook": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"push_delivery.0.event_hub_id", "push_delivery.0.webhook"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"url": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsURLWithHTTPS,
+								},
+
+								"active_directory_app_id_or_uri": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"active_directory_tenant_id": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"max_events_per_batch": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      1,
+									ValidateFunc: validation.IntBetween(1, 5000),
+								},
+
+								"preferred_batch_size_in_kilobytes": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      64,
+									ValidateFunc: validation.IntBetween(1, 1024),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"queue_delivery": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"push_delivery", "queue_delivery"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dead_letter_destination": namespaceTopicEventSubscriptionDeadLetterDestinationSchema(),
+
+					"event_time_to_live": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"max_delivery_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+
+					"receive_lock_duration_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntBetween(60, 300),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionResourceModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return eventsubscriptions.ValidateNamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			var config EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			topicId, err := namespacetopics.ParseNamespaceTopicID(config.NamespaceTopicId)
+			if err != nil {
+				return err
+			}
+
+			id := eventsubscriptions.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroupName, topicId.NamespaceName, topicId.TopicName, config.Name)
+
+			existing, err := client.NamespaceTopicEventSubscriptionsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			filters, err := expandNamespaceTopicEventSubscriptionFilters(config.IncludedEventTypes, config.AdvancedFilter)
+			if err != nil {
+				return err
+			}
+
+			deliveryConfiguration, err := expandNamespaceTopicEventSubscriptionDeliveryConfiguration(config.QueueDelivery, config.PushDelivery)
+			if err != nil {
+				return err
+			}
+
+			payload := eventsubscriptions.Subscription{
+				Properties: &eventsubscriptions.SubscriptionProperties{
+					DeliveryConfiguration: deliveryConfiguration,
+					EventDeliverySchema:   pointer.To(eventsubscriptions.DeliverySchema(config.EventDeliverySchema)),
+					FiltersConfiguration:  filters,
+				},
+			}
+
+			if config.ExpirationTimeUtc != "" {
+				payload.Properties.ExpirationTimeUtc = pointer.To(config.ExpirationTimeUtc)
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NamespaceTopicEventSubscriptionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionResourceModel{
+				Name:             id.EventSubscriptionName,
+				NamespaceTopicId: namespacetopics.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = pointer.FromEnum(props.EventDeliverySchema)
+					state.ExpirationTimeUtc = pointer.From(props.ExpirationTimeUtc)
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+						state.AdvancedFilter = flattenNamespaceTopicEventSubscriptionAdvancedFilters(filters.Filters)
+					}
+
+					if delivery := props.DeliveryConfiguration; delivery != nil {
+						state.QueueDelivery = flattenNamespaceTopicEventSubscriptionQueueDelivery(delivery.Queue)
+
+						if delivery.Push != nil {
+							// the Webhook URL isn't returned by the API since it may contain secrets, so we retrieve the full URL instead
+							var webhookUrl string
+							if _, ok := namespaceTopicEventSubscriptionPushDestination(delivery.Push).(eventsubscriptions.WebHookEventSubscriptionDestination); ok {
+								fullUrl, err := client.NamespaceTopicEventSubscriptionsGetFullURL(ctx, *id)
+								if err != nil {
+									return fmt.Errorf("retrieving the full URL for %s: %+v", *id, err)
+								}
+								if fullUrl.Model != nil {
+									webhookUrl = pointer.From(fullUrl.Model.EndpointURL)
+								}
+							}
+
+							state.PushDelivery = flattenNamespaceTopicEventSubscriptionPushDelivery(delivery.Push, webhookUrl)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config EventGridNamespaceTopicEventSubscriptionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := eventsubscriptions.SubscriptionUpdateParameters{
+				Properties: &eventsubscriptions.SubscriptionUpdateParametersProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("queue_delivery", "push_delivery") {
+				deliveryConfiguration, err := expandNamespaceTopicEventSubscriptionDeliveryConfiguration(config.QueueDelivery, config.PushDelivery)
+				if err != nil {
+					return err
+				}
+				payload.Properties.DeliveryConfiguration = deliveryConfiguration
+			}
+
+			if metadata.ResourceData.HasChange("event_delivery_schema") {
+				payload.Properties.EventDeliverySchema = pointer.To(eventsubscriptions.DeliverySchema(config.EventDeliverySchema))
+			}
+
+			if metadata.ResourceData.HasChange("expiration_time_utc") {
+				payload.Properties.ExpirationTimeUtc = pointer.To(config.ExpirationTimeUtc)
+			}
+
+			if metadata.ResourceData.HasChanges("included_event_types", "advanced_filter") {
+				filters, err := expandNamespaceTopicEventSubscriptionFilters(config.IncludedEventTypes, config.AdvancedFilter)
+				if err != nil {
+					return err
+				}
+				payload.Properties.FiltersConfiguration = filters
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.EventSubscriptions
+
+			id, err := eventsubscriptions.ParseNamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.NamespaceTopicEventSubscriptionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the delivery mode of an existing Event Subscription can't be changed
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("queue_delivery"); len(oldVal.([]interface{})) != len(newVal.([]interface{})) {
+				if err := metadata.ResourceDiff.ForceNew("queue_delivery"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func namespaceTopicEventSubscriptionDeadLetterDestinationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"storage_account_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: commonids.ValidateStorageAccountID,
+				},
+
+				"storage_blob_container_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"identity": namespaceTopicEventSubscriptionIdentitySchema(),
+			},
+		},
+	}
+}
+
+func namespaceTopicEventSubscriptionIdentitySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(eventsubscriptions.PossibleValuesForEventSubscriptionIdentityType(), false),
+				},
+
+				"user_assigned_identity_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+				},
+			},
+		},
+	}
+}
+
+// namespaceTopicEventSubscriptionFilterOperatorTypes returns the filter operators which are supported by the
+// `advanced_filter` block - the range operators are excluded since they take a list of ranges rather than values
+func namespaceTopicEventSubscriptionFilterOperatorTypes() []string {
+	output := make([]string, 0)
+	for _, v := range eventsubscriptions.PossibleValuesForFilterOperatorType() {
+		if v == string(eventsubscriptions.FilterOperatorTypeNumberInRange) || v == string(eventsubscriptions.FilterOperatorTypeNumberNotInRange) {
+			continue
+		}
+		output = append(output, v)
+	}
+	return output
+}
+
+func expandNamespaceTopicEventSubscriptionFilters(includedEventTypes []string, input []NamespaceTopicEventSubscriptionAdvancedFilter) (*eventsubscriptions.FiltersConfiguration, error) {
+	output := eventsubscriptions.FiltersConfiguration{
+		IncludedEventTypes: pointer.To(includedEventTypes),
+	}
+
+	filters := make([]eventsubscriptions.Filter, 0)
+	for _, v := range input {
+		filter, err := expandNamespaceTopicEventSubscriptionAdvancedFilter(v)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	output.Filters = &filters
+
+	return &output, nil
+}
+
+func expandNamespaceTopicEventSubscriptionAdvancedFilter(input NamespaceTopicEventSubscriptionAdvancedFilter) (eventsubscriptions.Filter, error) {
+	key := pointer.To(input.Key)
+	operatorType := eventsubscriptions.FilterOperatorType(input.OperatorType)
+
+	switch operatorType {
+	case eventsubscriptions.FilterOperatorTypeIsNotNull, eventsubscriptions.FilterOperatorTypeIsNullOrUndefined:
+		if input.Value != "" || len(input.Values) > 0 {
+			return nil, fmt.Errorf("neither `value` nor `values` can be specified for the advanced filter on %q when `operator_type` is `%s`", input.Key, operatorType)
+		}
+
+		if operatorType == eventsubscriptions.FilterOperatorTypeIsNotNull {
+			return eventsubscriptions.IsNotNullFilter{Key: key, OperatorType: operatorType}, nil
+		}
+		return eventsubscriptions.IsNullOrUndefinedFilter{Key: key, OperatorType: operatorType}, nil
+
+	case eventsubscriptions.FilterOperatorTypeBoolEquals:
+		if input.Value == "" || len(input.Values) > 0 {
+			return nil, fmt.Errorf("`value` (and not `values`) must be specified for the advanced filter on %q when `operator_type` is `%s`", input.Key, operatorType)
+		}
+
+		value, err := strconv.ParseBool(input.Value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `value` %q for the advanced filter on %q as a boolean: %+v", input.Value, input.Key, err)
+		}
+
+		return eventsubscriptions.BoolEqualsFilter{Key: key, OperatorType: operatorType, Value: pointer.To(value)}, nil
+
+	case eventsubscriptions.FilterOperatorTypeNumberGreaterThan, eventsubscriptions.FilterOperatorTypeNumberGreaterThanOrEquals,
+		eventsubscriptions.FilterOperatorTypeNumberLessThan, eventsubscriptions.FilterOperatorTypeNumberLessThanOrEquals:
+		if input.Value == "" || len(input.Values) > 0 {
+			return nil, fmt.Errorf("`value` (and not `values`) must be specified for the advanced filter on %q when `operator_type` is `%s`", input.Key, operatorType)
+		}
+
+		value, err := strconv.ParseFloat(input.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `value` %q for the advanced filter on %q as a number: %+v", input.Value, input.Key, err)
+		}
+
+		switch operatorType {
+		case eventsubscriptions.FilterOperatorTypeNumberGreaterThan:
+			return eventsubscriptions.NumberGreaterThanFilter{Key: key, OperatorType: operatorType, Value: pointer.To(value)}, nil
+		case eventsubscriptions.FilterOperatorTypeNumberGreaterThanOrEquals:
+			return eventsubscriptions.NumberGreaterThanOrEqualsFilter{Key: key, OperatorType: operatorType, Value: pointer.To(value)}, nil
+		case eventsubscriptions.FilterOperatorTypeNumberLessThan:
+			return eventsubscriptions.NumberLessThanFilter{Key: key, OperatorType: operatorType, Value: pointer.To(value)}, nil
+		default:
+			return eventsubscriptions.NumberLessThanOrEqualsFilter{Key: key, OperatorType: operatorType, Value: pointer.To(value)}, nil
+		}
+
+	case eventsubscriptions.FilterOperatorTypeNumberIn, eventsubscriptions.FilterOperatorTypeNumberNotIn:
+		if input.Value != "" || len(input.Values) == 0 {
+			return nil, fmt.Errorf("`values` (and not `value`) must be specified for the advanced filter on %q when `operator_type` is `%s`", input.Key, operatorType)
+		}
+
+		values := make([]float64, 0)
+		for _, v := range input.Values {
+			value, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing `values` item %q for the advanced filter on %q as a number: %+v", v, input.Key, err)
+			}
+			values = append(values, value)
+		}
+
+		if operatorType == eventsubscriptions.FilterOperatorTypeNumberIn {
+			return eventsubscriptions.NumberInFilter{Key: key, OperatorType: operatorType, Values: pointer.To(values)}, nil
+		}
+		return eventsubscriptions.NumberNotInFilter{Key: key, OperatorType: operatorType, Values: pointer.To(values)}, nil
+
+	case eventsubscriptions.FilterOperatorTypeStringBeginsWith, eventsubscriptions.FilterOperatorTypeStringNotBeginsWith,
+		eventsubscriptions.FilterOperatorTypeStringEndsWith, eventsubscriptions.FilterOperatorTypeStringNotEndsWith,
+		eventsubscriptions.FilterOperatorTypeStringContains, eventsubscriptions.FilterOperatorTypeStringNotContains,
+		eventsubscriptions.FilterOperatorTypeStringIn, eventsubscriptions.FilterOperatorTypeStringNotIn:
+		if input.Value != "" || len(input.Values) == 0 {
+			return nil, fmt.Errorf("`values` (and not `value`) must be specified for the advanced filter on %q when `operator_type` is `%s`", input.Key, operatorType)
+		}
+
+		values := pointer.To(input.Values)
+		switch operatorType {
+		case eventsubscriptions.FilterOperatorTypeStringBeginsWith:
+			return eventsubscriptions.StringBeginsWithFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		case eventsubscriptions.FilterOperatorTypeStringNotBeginsWith:
+			return eventsubscriptions.StringNotBeginsWithFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		case eventsubscriptions.FilterOperatorTypeStringEndsWith:
+			return eventsubscriptions.StringEndsWithFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		case eventsubscriptions.FilterOperatorTypeStringNotEndsWith:
+			return eventsubscriptions.StringNotEndsWithFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		case eventsubscriptions.FilterOperatorTypeStringContains:
+			return eventsubscriptions.StringContainsFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		case eventsubscriptions.FilterOperatorTypeStringNotContains:
+			return eventsubscriptions.StringNotContainsFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		case eventsubscriptions.FilterOperatorTypeStringIn:
+			return eventsubscriptions.StringInFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		default:
+			return eventsubscriptions.StringNotInFilter{Key: key, OperatorType: operatorType, Values: values}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported `operator_type` %q for the advanced filter on %q", operatorType, input.Key)
+}
+
+func flattenNamespaceTopicEventSubscriptionAdvancedFilters(input *[]eventsubscriptions.Filter) []NamespaceTopicEventSubscriptionAdvancedFilter {
+	output := make([]NamespaceTopicEventSubscriptionAdvancedFilter, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		base := item.Filter()
+		filter := NamespaceTopicEventSubscriptionAdvancedFilter{
+			Key: pointer.From(base.Key),
+		}
+
+		switch v := item.(type) {
+		case eventsubscriptions.IsNotNullFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeIsNotNull)
+		case eventsubscriptions.IsNullOrUndefinedFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeIsNullOrUndefined)
+		case eventsubscriptions.BoolEqualsFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeBoolEquals)
+			filter.Value = strconv.FormatBool(pointer.From(v.Value))
+		case eventsubscriptions.NumberGreaterThanFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeNumberGreaterThan)
+			filter.Value = formatNamespaceTopicEventSubscriptionNumber(pointer.From(v.Value))
+		case eventsubscriptions.NumberGreaterThanOrEqualsFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeNumberGreaterThanOrEquals)
+			filter.Value = formatNamespaceTopicEventSubscriptionNumber(pointer.From(v.Value))
+		case eventsubscriptions.NumberLessThanFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeNumberLessThan)
+			filter.Value = formatNamespaceTopicEventSubscriptionNumber(pointer.From(v.Value))
+		case eventsubscriptions.NumberLessThanOrEqualsFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeNumberLessThanOrEquals)
+			filter.Value = formatNamespaceTopicEventSubscriptionNumber(pointer.From(v.Value))
+		case eventsubscriptions.NumberInFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeNumberIn)
+			filter.Values = formatNamespaceTopicEventSubscriptionNumbers(v.Values)
+		case eventsubscriptions.NumberNotInFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeNumberNotIn)
+			filter.Values = formatNamespaceTopicEventSubscriptionNumbers(v.Values)
+		case eventsubscriptions.StringBeginsWithFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringBeginsWith)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotBeginsWithFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringNotBeginsWith)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringEndsWithFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringEndsWith)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotEndsWithFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringNotEndsWith)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringContainsFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringContains)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotContainsFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringNotContains)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringInFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringIn)
+			filter.Values = pointer.From(v.Values)
+		case eventsubscriptions.StringNotInFilter:
+			filter.OperatorType = string(eventsubscriptions.FilterOperatorTypeStringNotIn)
+			filter.Values = pointer.From(v.Values)
+		default:
+			// range filters can't be represented in the `advanced_filter` block, so are skipped
+			continue
+		}
+
+		output = append(output, filter)
+	}
+
+	return output
+}
+
+func formatNamespaceTopicEventSubscriptionNumber(input float64) string {
+	return strconv.FormatFloat(input, 'f', -1, 64)
+}
+
+func formatNamespaceTopicEventSubscriptionNumbers(input *[]float64) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, formatNamespaceTopicEventSubscriptionNumber(v))
+	}
+
+	return output
+}
+
+func expandNamespaceTopicEventSubscriptionDeliveryConfiguration(queue []NamespaceTopicEventSubscriptionQueueDelivery, push []NamespaceTopicEventSubscriptionPushDelivery) (*eventsubscriptions.DeliveryConfiguration, error) {
+	if len(queue) > 0 {
+		info := eventsubscriptions.QueueInfo{}
+
+		deadLetter, err := expandNamespaceTopicEventSubscriptionDeadLetterDestination(queue[0].DeadLetterDestination)
+		if err != nil {
+			return nil, err
+		}
+		info.DeadLetterDestinationWithResourceIdentity = deadLetter
+
+		if queue[0].EventTimeToLive != "" {
+			info.EventTimeToLive = pointer.To(queue[0].EventTimeToLive)
+		}
+
+		if queue[0].MaxDeliveryCount != 0 {
+			info.MaxDeliveryCount = pointer.To(queue[0].MaxDeliveryCount)
+		}
+
+		if queue[0].ReceiveLockDurationInSeconds != 0 {
+			info.ReceiveLockDurationInSeconds = pointer.To(queue[0].ReceiveLockDurationInSeconds)
+		}
+
+		return &eventsubscriptions.DeliveryConfiguration{
+			DeliveryMode: pointer.To(eventsubscriptions.DeliveryModeQueue),
+			Queue:        &info,
+		}, nil
+	}
+
+	if len(push) == 0 {
+		return nil, nil
+	}
+
+	info := eventsubscriptions.PushInfo{}
+
+	deadLetter, err := expandNamespaceTopicEventSubscriptionDeadLetterDestination(push[0].DeadLetterDestination)
+	if err != nil {
+		return nil, err
+	}
+	info.DeadLetterDestinationWithResourceIdentity = deadLetter
+
+	var destination eventsubscriptions.EventSubscriptionDestination
+	if push[0].EventHubId != "" {
+		destination = eventsubscriptions.EventHubEventSubscriptionDestination{
+			EndpointType: eventsubscriptions.EndpointTypeEventHub,
+			Properties: &eventsubscriptions.EventHubEventSubscriptionDestinationProperties{
+				ResourceId: pointer.To(push[0].EventHubId),
+			},
+		}
+	}
+
+	if len(push[0].Webhook) > 0 {
+		webhook := push[0].Webhook[0]
+		props := eventsubscriptions.WebHookEventSubscriptionDestinationProperties{
+			EndpointURL:                   pointer.To(webhook.Url),
+			MaxEventsPerBatch:             pointer.To(webhook.MaxEventsPerBatch),
+			PreferredBatchSizeInKilobytes: pointer.To(webhook.PreferredBatchSizeInKilobytes),
+		}
+
+		if webhook.ActiveDirectoryTenantId != "" {
+			props.AzureActiveDirectoryTenantId = pointer.To(webhook.ActiveDirectoryTenantId)
+		}
+
+		if webhook.ActiveDirectoryAppIdOrUri != "" {
+			props.AzureActiveDirectoryApplicationIdOrUri = pointer.To(webhook.ActiveDirectoryAppIdOrUri)
+		}
+
+		destination = eventsubscriptions.WebHookEventSubscriptionDestination{
+			EndpointType: eventsubscriptions.EndpointTypeWebHook,
+			Properties:   &props,
+		}
+	}
+
+	if len(push[0].DeliveryIdentity) > 0 {
+		identity, err := expandNamespaceTopicEventSubscriptionIdentity(push[0].DeliveryIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		info.DeliveryWithResourceIdentity = &eventsubscriptions.DeliveryWithResourceIdentity{
+			Destination: destination,
+			Identity:    identity,
+		}
+	} else {
+		info.Destination = destination
+	}
+
+	if push[0].EventTimeToLive != "" {
+		info.EventTimeToLive = pointer.To(push[0].EventTimeToLive)
+	}
+
+	if push[0].MaxDeliveryCount != 0 {
+		info.MaxDeliveryCount = pointer.To(push[0].MaxDeliveryCount)
+	}
+
+	return &eventsubscriptions.DeliveryConfiguration{
+		DeliveryMode: pointer.To(eventsubscriptions.DeliveryModePush),
+		Push:         &info,
+	}, nil
+}
+
+func flattenNamespaceTopicEventSubscriptionQueueDelivery(input *eventsubscriptions.QueueInfo) []NamespaceTopicEventSubscriptionQueueDelivery {
+	if input == nil {
+		return []NamespaceTopicEventSubscriptionQueueDelivery{}
+	}
+
+	return []NamespaceTopicEventSubscriptionQueueDelivery{
+		{
+			DeadLetterDestination:        flattenNamespaceTopicEventSubscriptionDeadLetterDestination(input.DeadLetterDestinationWithResourceIdentity),
+			EventTimeToLive:              pointer.From(input.EventTimeToLive),
+			MaxDeliveryCount:             pointer.From(input.MaxDeliveryCount),
+			ReceiveLockDurationInSeconds: pointer.From(input.ReceiveLockDurationInSeconds),
+		},
+	}
+}
+
+// namespaceTopicEventSubscriptionPushDestination returns the destination for push delivery, which is nested within
+// `DeliveryWithResourceIdentity` when a delivery identity is used
+func namespaceTopicEventSubscriptionPushDestination(input *eventsubscriptions.PushInfo) eventsubscriptions.EventSubscriptionDestination {
+	if input.DeliveryWithResourceIdentity != nil && input.DeliveryWithResourceIdentity.Destination != nil {
+		return input.DeliveryWithResourceIdentity.Destination
+	}
+	return input.Destination
+}
+
+func flattenNamespaceTopicEventSubscriptionPushDelivery(input *eventsubscriptions.PushInfo, webhookUrl string) []NamespaceTopicEventSubscriptionPushDelivery {
+	if input == nil {
+		return []NamespaceTopicEventSubscriptionPushDelivery{}
+	}
+
+	output := NamespaceTopicEventSubscriptionPushDelivery{
+		DeadLetterDestination: flattenNamespaceTopicEventSubscriptionDeadLetterDestination(input.DeadLetterDestinationWithResourceIdentity),
+		DeliveryIdentity:      []NamespaceTopicEventSubscriptionIdentity{},
+		EventTimeToLive:       pointer.From(input.EventTimeToLive),
+		MaxDeliveryCount:      pointer.From(input.MaxDeliveryCount),
+		Webhook:               []NamespaceTopicEventSubscriptionWebhook{},
+	}
+
+	if input.DeliveryWithResourceIdentity != nil {
+		output.DeliveryIdentity = flattenNamespaceTopicEventSubscriptionIdentity(input.DeliveryWithResourceIdentity.Identity)
+	}
+
+	switch v := namespaceTopicEventSubscriptionPushDestination(input).(type) {
+	case eventsubscriptions.EventHubEventSubscriptionDestination:
+		if v.Properties != nil {
+			output.EventHubId = pointer.From(v.Properties.ResourceId)
+		}
+	case eventsubscriptions.WebHookEventSubscriptionDestination:
+		webhook := NamespaceTopicEventSubscriptionWebhook{
+			Url: webhookUrl,
+		}
+		if props := v.Properties; props != nil {
+			webhook.ActiveDirectoryAppIdOrUri = pointer.From(props.AzureActiveDirectoryApplicationIdOrUri)
+			webhook.ActiveDirectoryTenantId = pointer.From(props.AzureActiveDirectoryTenantId)
+			webhook.MaxEventsPerBatch = pointer.From(props.MaxEventsPerBatch)
+			webhook.PreferredBatchSizeInKilobytes = pointer.From(props.PreferredBatchSizeInKilobytes)
+		}
+		output.Webhook = []NamespaceTopicEventSubscriptionWebhook{webhook}
+	}
+
+	return []NamespaceTopicEventSubscriptionPushDelivery{output}
+}
+
+func expandNamespaceTopicEventSubscriptionDeadLetterDestination(input []NamespaceTopicEventSubscriptionDeadLetterDestination) (*eventsubscriptions.DeadLetterWithResourceIdentity, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	output := eventsubscriptions.DeadLetterWithResourceIdentity{
+		DeadLetterDestination: eventsubscriptions.StorageBlobDeadLetterDestination{
+			EndpointType: eventsubscriptions.DeadLetterEndPointTypeStorageBlob,
+			Properties: &eventsubscriptions.StorageBlobDeadLetterDestinationProperties{
+				BlobContainerName: pointer.To(input[0].StorageBlobContainerName),
+				ResourceId:        pointer.To(input[0].StorageAccountId),
+			},
+		},
+	}
+
+	if len(input[0].Identity) > 0 {
+		identity, err := expandNamespaceTopicEventSubscriptionIdentity(input[0].Identity)
+		if err != nil {
+			return nil, err
+		}
+		output.Identity = identity
+	}
+
+	return &output, nil
+}
+
+func flattenNamespaceTopicEventSubscriptionDeadLetterDestination(input *eventsubscriptions.DeadLetterWithResourceIdentity) []NamespaceTopicEventSubscriptionDeadLetterDestination {
+	if input == nil {
+		return []NamespaceTopicEventSubscriptionDeadLetterDestination{}
+	}
+
+	destination, ok := input.DeadLetterDestination.(eventsubscriptions.StorageBlobDeadLetterDestination)
+	if !ok || destination.Properties == nil {
+		return []NamespaceTopicEventSubscriptionDeadLetterDestination{}
+	}
+
+	return []NamespaceTopicEventSubscriptionDeadLetterDestination{
+		{
+			StorageAccountId:         pointer.From(destination.Properties.ResourceId),
+			StorageBlobContainerName: pointer.From(destination.Properties.BlobContainerName),
+			Identity:                 flattenNamespaceTopicEventSubscriptionIdentity(input.Identity),
+		},
+	}
+}
+
+func expandNamespaceTopicEventSubscriptionIdentity(input []NamespaceTopicEventSubscriptionIdentity) (*eventsubscriptions.EventSubscriptionIdentity, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	identityType := eventsubscriptions.EventSubscriptionIdentityType(input[0].Type)
+	output := eventsubscriptions.EventSubscriptionIdentity{
+		Type: pointer.To(identityType),
+	}
+
+	if identityType == eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned {
+		if input[0].UserAssignedIdentityId == "" {
+			return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when `type` is `%s`", identityType)
+		}
+		output.UserAssignedIdentity = pointer.To(input[0].UserAssignedIdentityId)
+	} else if input[0].UserAssignedIdentityId != "" {
+		return nil, fmt.Errorf("`user_assigned_identity_id` can only be specified when `type` is `%s`; but `type` is currently %q", eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned, identityType)
+	}
+
+	return &output, nil
+}
+
+func flattenNamespaceTopicEventSubscriptionIdentity(input *eventsubscriptions.EventSubscriptionIdentity) []NamespaceTopicEventSubscriptionIdentity {
+	if input == nil || input.Type == nil {
+		return []NamespaceTopicEventSubscriptionIdentity{}
+	}
+
+	return []NamespaceTopicEventSubscriptionIdentity{
+		{
+			Type:                   string(*input.Type),
+			UserAssignedIdentityId: pointer.From(input.UserAssignedIdentity),
+		},
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type EventGridNamespaceTopicSpaceDataSource struct{}
+
+func TestAccEventGridNamespaceTopicSpaceDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_eventgrid_namespace_topic_space", "test")
+	r := EventGridNamespaceTopicSpaceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("topic_templates.#").HasValue("2"),
+				check.That(data.ResourceName).Key("description").HasValue("Sensor telemetry and alerts"),
+			),
+		},
+	})
+}
+
+func (EventGridNamespaceTopicSpaceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_eventgrid_namespace_topic_space" "test" {
+  name         = azurerm_eventgrid_namespace_topic_space.test.name
+  namespace_id = azurerm_eventgrid_namespace_topic_space.test.namespace_id
+}
+`, EventGridNamespaceTopicSpaceResource{}.complete(data))
+}
@@ -30,6 +30,11 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		EventGridNamespaceCaCertificateDataSource{},
+		EventGridNamespaceClientDataSource{},
+		EventGridNamespaceClientGroupDataSource{},
+		EventGridNamespacePermissionBindingDataSource{},
+		EventGridNamespaceTopicSpaceDataSource{},
 		EventGridPartnerNamespaceDataSource{},
 		EventGridPartnerRegistrationDataSource{},
 	}
@@ -37,7 +42,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		EventGridNamespaceCaCertificateResource{},
+		EventGridNamespaceClientGroupResource{},
+		EventGridNamespaceClientResource{},
+		EventGridNamespacePermissionBindingResource{},
 		EventGridNamespaceResource{},
+		EventGridNamespaceTopicEventSubscriptionResource{},
+		EventGridNamespaceTopicResource{},
+		EventGridNamespaceTopicSpaceResource{},
 		EventGridPartnerConfigurationResource{},
 		EventGridPartnerNamespaceResource{},
 		EventGridPartnerRegistrationResource{},
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenseprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenses"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseProfileResourceModel struct {
+	ArcMachineId               string                           `tfschema:"arc_machine_id"`
+	Location                   string                           `tfschema:"location"`
+	EsuLicenseId               string                           `tfschema:"esu_license_id"`
+	Tags                       map[string]string                `tfschema:"tags"`
+	AssignedLicenseImmutableId string                           `tfschema:"assigned_license_immutable_id"`
+	EsuEligibility             string                           `tfschema:"esu_eligibility"`
+	EsuKey                     []ArcMachineLicenseProfileEsuKey `tfschema:"esu_key"`
+	EsuKeyState                string                           `tfschema:"esu_key_state"`
+	ServerType                 string                           `tfschema:"server_type"`
+}
+
+type ArcMachineLicenseProfileEsuKey struct {
+	LicenseStatus int64  `tfschema:"license_status"`
+	Sku           string `tfschema:"sku"`
+}
+
+type ArcMachineLicenseProfileResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineLicenseProfileResource{}
+
+func (r ArcMachineLicenseProfileResource) ResourceType() string {
+	return "azurerm_arc_machine_license_profile"
+}
+
+func (r ArcMachineLicenseProfileResource) ModelObject() interface{} {
+	return &ArcMachineLicenseProfileResourceModel{}
+}
+
+func (r ArcMachineLicenseProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ArcMachineLicenseProfileID
+}
+
+func (r ArcMachineLicenseProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"esu_license_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: licenses.ValidateLicenseID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assigned_license_immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_eligibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_key": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"license_status": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"sku": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"esu_key_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			var model ArcMachineLicenseProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := licenseprofiles.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewArcMachineLicenseProfileID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName)
+
+			existing, err := client.Get(ctx, *machineId)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := licenseprofiles.LicenseProfile{
+				Location: location.Normalize(model.Location),
+				Properties: &licenseprofiles.LicenseProfileProperties{
+					EsuProfile: &licenseprofiles.LicenseProfileArmEsuProperties{
+						AssignedLicense: pointer.To(model.EsuLicenseId),
+					},
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *machineId, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			machineId := licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName)
+
+			resp, err := client.Get(ctx, machineId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineLicenseProfileResourceModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil && props.EsuProfile != nil {
+					esuProfile := props.EsuProfile
+
+					if v := pointer.From(esuProfile.AssignedLicense); v != "" {
+						licenseId, err := licenses.ParseLicenseIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.EsuLicenseId = licenseId.ID()
+					}
+
+					state.AssignedLicenseImmutableId = pointer.From(esuProfile.AssignedLicenseImmutableId)
+					state.EsuEligibility = string(pointer.From(esuProfile.EsuEligibility))
+					state.EsuKey = flattenArcMachineLicenseProfileEsuKeys(esuProfile.EsuKeys)
+					state.EsuKeyState = string(pointer.From(esuProfile.EsuKeyState))
+					state.ServerType = string(pointer.From(esuProfile.ServerType))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineLicenseProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := licenseprofiles.LicenseProfileUpdate{
+				Properties: &licenseprofiles.LicenseProfileUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("esu_license_id") {
+				parameters.Properties.EsuProfile = &licenseprofiles.EsuProfileUpdateProperties{
+					AssignedLicense: pointer.To(model.EsuLicenseId),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = pointer.To(model.Tags)
+			}
+
+			machineId := licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName)
+			if err := client.UpdateThenPoll(ctx, machineId, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			machineId := licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName)
+			if err := client.DeleteThenPoll(ctx, machineId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenArcMachineLicenseProfileEsuKeys(input *[]licenseprofiles.EsuKey) []ArcMachineLicenseProfileEsuKey {
+	output := make([]ArcMachineLicenseProfileEsuKey, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		output = append(output, ArcMachineLicenseProfileEsuKey{
+			LicenseStatus: pointer.From(item.LicenseStatus),
+			Sku:           pointer.From(item.Sku),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ESU licenses can only be assigned to connected Arc machines running Windows Server 2012 or 2012 R2,
+// which can't be provisioned as part of the test
+const arcEsuMachineIdEnv = "ARM_TEST_ARC_ESU_MACHINE_ID"
+
+type ArcMachineLicenseProfileResource struct{}
+
+func TestAccArcMachineLicenseProfile_basic(t *testing.T) {
+	if os.Getenv(arcEsuMachineIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", arcEsuMachineIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("esu_eligibility").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_requiresImport(t *testing.T) {
+	if os.Getenv(arcEsuMachineIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", arcEsuMachineIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_update(t *testing.T) {
+	if os.Getenv(arcEsuMachineIdEnv) == "" {
+		t.Skipf("skipping since %q has not been specified", arcEsuMachineIdEnv)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachineLicenseProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ArcMachineLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles.Get(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcMachineLicenseProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id = data.azurerm_arc_machine.test.id
+  location       = data.azurerm_arc_machine.test.location
+  esu_license_id = azurerm_arc_machine_license.first.id
+}
+`, r.template(data))
+}
+
+func (r ArcMachineLicenseProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "import" {
+  arc_machine_id = azurerm_arc_machine_license_profile.test.arc_machine_id
+  location       = azurerm_arc_machine_license_profile.test.location
+  esu_license_id = azurerm_arc_machine_license_profile.test.esu_license_id
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineLicenseProfileResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id = data.azurerm_arc_machine.test.id
+  location       = data.azurerm_arc_machine.test.location
+  esu_license_id = azurerm_arc_machine_license.second.id
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, r.template(data))
+}
+
+func (r ArcMachineLicenseProfileResource) template(data acceptance.TestData) string {
+	machineName, resourceGroupName := "", ""
+	if id, err := licenseprofiles.ParseMachineID(os.Getenv(arcEsuMachineIdEnv)); err == nil {
+		machineName = id.MachineName
+		resourceGroupName = id.ResourceGroupName
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_arc_machine" "test" {
+  name                = "%[1]s"
+  resource_group_name = "%[2]s"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-hclp-%[3]d"
+  location = data.azurerm_arc_machine.test.location
+}
+
+resource "azurerm_arc_machine_license" "first" {
+  name                = "acctest-hcl-first-%[3]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_count          = 8
+  core_type           = "vCore"
+  edition             = "Standard"
+  state               = "Activated"
+  target              = "Windows Server 2012 R2"
+}
+
+resource "azurerm_arc_machine_license" "second" {
+  name                = "acctest-hcl-second-%[3]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  core_count          = 8
+  core_type           = "vCore"
+  edition             = "Datacenter"
+  state               = "Activated"
+  target              = "Windows Server 2012 R2"
+}
+`, machineName, resourceGroupName, data.RandomInteger)
+}
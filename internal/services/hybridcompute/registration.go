@@ -53,6 +53,8 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ArcMachineResource{},
 		ArcMachineExtensionResource{},
+		ArcMachineLicenseResource{},
+		ArcMachineLicenseProfileResource{},
 		ArcPrivateLinkScopeResource{},
 	}
 }
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/advancedthreatprotectionsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the API only exposes a single, always present, settings object per server
+const mysqlFlexibleServerAdvancedThreatProtectionSettingName = "default"
+
+type MySQLFlexibleServerAdvancedThreatProtectionModel struct {
+	ServerId     string `tfschema:"server_id"`
+	Enabled      bool   `tfschema:"enabled"`
+	CreationTime string `tfschema:"creation_time"`
+}
+
+type MySQLFlexibleServerAdvancedThreatProtectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = MySQLFlexibleServerAdvancedThreatProtectionResource{}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) ResourceType() string {
+	return "azurerm_mysql_flexible_server_advanced_threat_protection"
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) ModelObject() interface{} {
+	return &MySQLFlexibleServerAdvancedThreatProtectionModel{}
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FlexibleServerAdvancedThreatProtectionID
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: advancedthreatprotectionsettings.ValidateFlexibleServerID,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+	}
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"creation_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.AdvancedThreatProtectionSettings
+
+			var model MySQLFlexibleServerAdvancedThreatProtectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			flexibleServerId, err := advancedthreatprotectionsettings.ParseFlexibleServerID(model.ServerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFlexibleServerAdvancedThreatProtectionID(flexibleServerId.SubscriptionId, flexibleServerId.ResourceGroupName, flexibleServerId.FlexibleServerName, mysqlFlexibleServerAdvancedThreatProtectionSettingName)
+
+			locks.ByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+			defer locks.UnlockByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+
+			// the settings always exist, so treat them as already managed when they've been enabled outside of Terraform
+			existing, err := client.Get(ctx, *flexibleServerId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && pointer.From(existing.Model.Properties.State) == advancedthreatprotectionsettings.AdvancedThreatProtectionStateEnabled {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := advancedthreatprotectionsettings.AdvancedThreatProtectionForUpdate{
+				Properties: &advancedthreatprotectionsettings.AdvancedThreatProtectionUpdateProperties{
+					State: expandMySQLFlexibleServerAdvancedThreatProtectionState(model.Enabled),
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *flexibleServerId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.AdvancedThreatProtectionSettings
+
+			id, err := parse.FlexibleServerAdvancedThreatProtectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			flexibleServerId := advancedthreatprotectionsettings.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)
+
+			resp, err := client.Get(ctx, flexibleServerId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MySQLFlexibleServerAdvancedThreatProtectionModel{
+				ServerId: flexibleServerId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Enabled = pointer.From(props.State) == advancedthreatprotectionsettings.AdvancedThreatProtectionStateEnabled
+					state.CreationTime = pointer.From(props.CreationTime)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.AdvancedThreatProtectionSettings
+
+			id, err := parse.FlexibleServerAdvancedThreatProtectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MySQLFlexibleServerAdvancedThreatProtectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			flexibleServerId := advancedthreatprotectionsettings.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)
+
+			locks.ByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+			defer locks.UnlockByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload := advancedthreatprotectionsettings.AdvancedThreatProtectionForUpdate{
+					Properties: &advancedthreatprotectionsettings.AdvancedThreatProtectionUpdateProperties{
+						State: expandMySQLFlexibleServerAdvancedThreatProtectionState(model.Enabled),
+					},
+				}
+
+				if err := client.UpdateThenPoll(ctx, flexibleServerId, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MySQLFlexibleServerAdvancedThreatProtectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.AdvancedThreatProtectionSettings
+
+			id, err := parse.FlexibleServerAdvancedThreatProtectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			flexibleServerId := advancedthreatprotectionsettings.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)
+
+			locks.ByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+			defer locks.UnlockByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+
+			// the settings can't be removed, so disable Advanced Threat Protection instead
+			payload := advancedthreatprotectionsettings.AdvancedThreatProtectionForUpdate{
+				Properties: &advancedthreatprotectionsettings.AdvancedThreatProtectionUpdateProperties{
+					State: advancedthreatprotectionsettings.AdvancedThreatProtectionStateDisabled,
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, flexibleServerId, payload); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMySQLFlexibleServerAdvancedThreatProtectionState(enabled bool) advancedthreatprotectionsettings.AdvancedThreatProtectionState {
+	if enabled {
+		return advancedthreatprotectionsettings.AdvancedThreatProtectionStateEnabled
+	}
+
+	return advancedthreatprotectionsettings.AdvancedThreatProtectionStateDisabled
+}
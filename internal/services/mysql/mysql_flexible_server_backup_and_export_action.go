@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/backupandexport"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MySQLFlexibleServerBackupAndExportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MySQLFlexibleServerBackupAndExportAction{}
+
+func newMySQLFlexibleServerBackupAndExportAction() action.Action {
+	return &MySQLFlexibleServerBackupAndExportAction{}
+}
+
+type MySQLFlexibleServerBackupAndExportActionModel struct {
+	FlexibleServerId types.String `tfsdk:"flexible_server_id"`
+	BackupName       types.String `tfsdk:"backup_name"`
+	BackupFormat     types.String `tfsdk:"backup_format"`
+	SasUris          types.List   `tfsdk:"sas_uris"`
+	Timeouts         types.Object `tfsdk:"timeouts"`
+}
+
+func (m *MySQLFlexibleServerBackupAndExportAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"flexible_server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the MySQL Flexible Server to back up.",
+				MarkdownDescription: "The ID of the MySQL Flexible Server to back up.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"backup_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the on-demand backup.",
+				MarkdownDescription: "The name of the on-demand backup.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"backup_format": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The format of the exported backup. Possible values include `CollatedFormat` and `Raw`.",
+				MarkdownDescription: "The format of the exported backup. Possible values include `CollatedFormat` and `Raw`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(backupandexport.PossibleValuesForBackupFormat()...),
+				},
+			},
+
+			"sas_uris": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "A list of SAS URIs of Storage Containers to which the backup should be exported.",
+				MarkdownDescription: "A list of SAS URIs of Storage Containers to which the backup should be exported.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sdk.ActionTimeoutsBlock(),
+		},
+	}
+}
+
+func (m *MySQLFlexibleServerBackupAndExportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mysql_flexible_server_backup_and_export"
+}
+
+func (m *MySQLFlexibleServerBackupAndExportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MySQL.FlexibleServers.BackupAndExport
+
+	model := MySQLFlexibleServerBackupAndExportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := m.InvokeTimeout(ctx, model.Timeouts, time.Hour*3)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	serverId, err := servers.ParseFlexibleServerID(model.FlexibleServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	id := backupandexport.NewFlexibleServerID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.FlexibleServerName)
+
+	sasUris := make([]string, 0)
+	response.Diagnostics.Append(model.SasUris.ElementsAs(ctx, &sasUris, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// the number of containers required depends on the size of the server, so check enough have been provided up front
+	validation, err := client.ValidateBackup(ctx, id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("validating backup for %s: %+v", id, err))
+		return
+	}
+	if validation.Model != nil && validation.Model.Properties != nil {
+		if required := pointer.From(validation.Model.Properties.NumberOfContainers); int64(len(sasUris)) < required {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("backing up %s requires %d storage containers but %d `sas_uris` were specified", id, required, len(sasUris)))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backing up and exporting %s", id.FlexibleServerName),
+	})
+
+	payload := backupandexport.BackupAndExportRequest{
+		BackupSettings: backupandexport.BackupSettings{
+			BackupName: model.BackupName.ValueString(),
+		},
+		TargetDetails: backupandexport.FullBackupStoreDetails{
+			SasUriList: sasUris,
+		},
+	}
+
+	if !model.BackupFormat.IsNull() {
+		payload.BackupSettings.BackupFormat = pointer.To(backupandexport.BackupFormat(model.BackupFormat.ValueString()))
+	}
+
+	result, err := client.Create(ctx, id, payload)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("backing up and exporting %s: %+v", id, err))
+		return
+	}
+	if err := m.PollWithProgress(ctx, response, fmt.Sprintf("backing up and exporting %s", id.FlexibleServerName), result.Poller); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("backing up and exporting %s completed", id.FlexibleServerName),
+	})
+}
+
+func (m *MySQLFlexibleServerBackupAndExportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
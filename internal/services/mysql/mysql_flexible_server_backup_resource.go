@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/backupsv2"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = MySQLFlexibleServerBackupResource{}
+
+type MySQLFlexibleServerBackupResource struct{}
+
+func (r MySQLFlexibleServerBackupResource) ModelObject() interface{} {
+	return &MySQLFlexibleServerBackupResourceModel{}
+}
+
+type MySQLFlexibleServerBackupResourceModel struct {
+	Name          string `tfschema:"name"`
+	ServerId      string `tfschema:"server_id"`
+	BackupType    string `tfschema:"backup_type"`
+	CompletedTime string `tfschema:"completed_time"`
+	Source        string `tfschema:"source"`
+}
+
+func (r MySQLFlexibleServerBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return backupsv2.ValidateBackupsV2ID
+}
+
+func (r MySQLFlexibleServerBackupResource) ResourceType() string {
+	return "azurerm_mysql_flexible_server_backup"
+}
+
+func (r MySQLFlexibleServerBackupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FlexibleServerBackupName,
+		},
+
+		"server_id": commonschema.ResourceIDReferenceRequiredForceNew(&backupsv2.FlexibleServerId{}),
+	}
+}
+
+func (r MySQLFlexibleServerBackupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backup_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"completed_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MySQLFlexibleServerBackupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.BackupsV2
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MySQLFlexibleServerBackupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serverId, err := backupsv2.ParseFlexibleServerID(model.ServerId)
+			if err != nil {
+				return err
+			}
+
+			id := backupsv2.NewBackupsV2ID(subscriptionId, serverId.ResourceGroupName, serverId.FlexibleServerName, model.Name)
+
+			locks.ByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+			defer locks.UnlockByName(id.FlexibleServerName, mysqlFlexibleServerResourceName)
+
+			existing, err := client.LongRunningBackupsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := backupsv2.ServerBackupV2{
+				Properties: &backupsv2.ServerBackupPropertiesV2{
+					BackupNameV2: pointer.To(model.Name),
+				},
+			}
+
+			if err := client.LongRunningBackupCreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MySQLFlexibleServerBackupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.BackupsV2
+
+			id, err := backupsv2.ParseBackupsV2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.LongRunningBackupsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MySQLFlexibleServerBackupResourceModel{
+				Name:     id.BackupsV2Name,
+				ServerId: backupsv2.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BackupType = string(pointer.From(props.BackupType))
+					state.CompletedTime = pointer.From(props.CompletedTime)
+					state.Source = pointer.From(props.Source)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MySQLFlexibleServerBackupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := backupsv2.ParseBackupsV2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// there is no API to delete an on-demand backup, it's removed by the service once the backup retention period has passed
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+
+			return nil
+		},
+	}
+}
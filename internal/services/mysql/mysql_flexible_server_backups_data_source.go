@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/backupsv2"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MySQLFlexibleServerBackupsDataSource struct{}
+
+var _ sdk.DataSource = MySQLFlexibleServerBackupsDataSource{}
+
+type MySQLFlexibleServerBackupsDataSourceModel struct {
+	ServerId string                                  `tfschema:"server_id"`
+	Backups  []MySQLFlexibleServerBackupsBackupModel `tfschema:"backups"`
+}
+
+type MySQLFlexibleServerBackupsBackupModel struct {
+	Id            string `tfschema:"id"`
+	Name          string `tfschema:"name"`
+	BackupType    string `tfschema:"backup_type"`
+	CompletedTime string `tfschema:"completed_time"`
+	Source        string `tfschema:"source"`
+}
+
+func (r MySQLFlexibleServerBackupsDataSource) ResourceType() string {
+	return "azurerm_mysql_flexible_server_backups"
+}
+
+func (r MySQLFlexibleServerBackupsDataSource) ModelObject() interface{} {
+	return &MySQLFlexibleServerBackupsDataSourceModel{}
+}
+
+func (r MySQLFlexibleServerBackupsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: backupsv2.ValidateFlexibleServerID,
+		},
+	}
+}
+
+func (r MySQLFlexibleServerBackupsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backups": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"backup_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"completed_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MySQLFlexibleServerBackupsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.BackupsV2
+
+			var state MySQLFlexibleServerBackupsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := backupsv2.ParseFlexibleServerID(state.ServerId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.LongRunningBackupsListComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing backups for %s: %+v", id, err)
+			}
+
+			state.Backups = flattenMySQLFlexibleServerBackups(resp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenMySQLFlexibleServerBackups(input []backupsv2.ServerBackupV2) []MySQLFlexibleServerBackupsBackupModel {
+	output := make([]MySQLFlexibleServerBackupsBackupModel, 0, len(input))
+
+	for _, item := range input {
+		backup := MySQLFlexibleServerBackupsBackupModel{
+			Id:   pointer.From(item.Id),
+			Name: pointer.From(item.Name),
+		}
+
+		if props := item.Properties; props != nil {
+			backup.BackupType = string(pointer.From(props.BackupType))
+			backup.CompletedTime = pointer.From(props.CompletedTime)
+			backup.Source = pointer.From(props.Source)
+		}
+
+		output = append(output, backup)
+	}
+
+	return output
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MySQLFlexibleServerBackupsDataSource struct{}
+
+func TestAccMySQLFlexibleServerBackupsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mysql_flexible_server_backups", "test")
+	d := MySQLFlexibleServerBackupsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("backups.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("backups.0.id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("backups.0.name").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (d MySQLFlexibleServerBackupsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mysql_flexible_server_backups" "test" {
+  server_id = azurerm_mysql_flexible_server_backup.test.server_id
+}
+`, MySQLFlexibleServerBackupResource{}.basic(data))
+}
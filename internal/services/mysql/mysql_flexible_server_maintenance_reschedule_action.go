@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/maintenances"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MySQLFlexibleServerMaintenanceRescheduleAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MySQLFlexibleServerMaintenanceRescheduleAction{}
+
+func newMySQLFlexibleServerMaintenanceRescheduleAction() action.Action {
+	return &MySQLFlexibleServerMaintenanceRescheduleAction{}
+}
+
+type MySQLFlexibleServerMaintenanceRescheduleActionModel struct {
+	MaintenanceId types.String `tfsdk:"maintenance_id"`
+	StartTime     types.String `tfsdk:"start_time"`
+	Timeouts      types.Object `tfsdk:"timeouts"`
+}
+
+func (m *MySQLFlexibleServerMaintenanceRescheduleAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"maintenance_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the scheduled MySQL Flexible Server Maintenance to reschedule.",
+				MarkdownDescription: "The ID of the scheduled MySQL Flexible Server Maintenance to reschedule.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: maintenances.ValidateMaintenanceID,
+					},
+				},
+			},
+
+			"start_time": schema.StringAttribute{
+				Required:            true,
+				Description:         "The new start time of the Maintenance, in RFC3339 format. This must fall within the available schedule window of the Maintenance.",
+				MarkdownDescription: "The new start time of the Maintenance, in RFC3339 format. This must fall within the available schedule window of the Maintenance.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sdk.ActionTimeoutsBlock(),
+		},
+	}
+}
+
+func (m *MySQLFlexibleServerMaintenanceRescheduleAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mysql_flexible_server_maintenance_reschedule"
+}
+
+func (m *MySQLFlexibleServerMaintenanceRescheduleAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MySQL.FlexibleServers.Maintenances
+
+	model := MySQLFlexibleServerMaintenanceRescheduleActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := m.InvokeTimeout(ctx, model.Timeouts, time.Minute*30)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := maintenances.ParseMaintenanceID(model.MaintenanceId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	startTime, err := time.Parse(time.RFC3339, model.StartTime.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "invalid configuration", fmt.Sprintf("`start_time` must be in RFC3339 format: %+v", err))
+		return
+	}
+
+	existing, err := client.Read(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+	if existing.Model == nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: `model` was nil", id))
+		return
+	}
+
+	props := existing.Model.Properties
+	if state := pointer.From(props.MaintenanceState); state != maintenances.MaintenanceStateScheduled && state != maintenances.MaintenanceStateReScheduled {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("%s can only be rescheduled when it is `Scheduled` or `ReScheduled`, got `%s`", id, state))
+		return
+	}
+
+	if minTime, err := props.GetMaintenanceAvailableScheduleMinTimeAsTime(); err == nil && minTime != nil && startTime.Before(*minTime) {
+		sdk.SetResponseErrorDiagnostic(response, "invalid configuration", fmt.Sprintf("`start_time` must not be earlier than %s for %s", minTime.Format(time.RFC3339), id))
+		return
+	}
+	if maxTime, err := props.GetMaintenanceAvailableScheduleMaxTimeAsTime(); err == nil && maxTime != nil && startTime.After(*maxTime) {
+		sdk.SetResponseErrorDiagnostic(response, "invalid configuration", fmt.Sprintf("`start_time` must not be later than %s for %s", maxTime.Format(time.RFC3339), id))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rescheduling maintenance %s on %s", id.MaintenanceName, id.FlexibleServerName),
+	})
+
+	payload := maintenances.MaintenanceUpdate{
+		Properties: &maintenances.MaintenancePropertiesForUpdate{},
+	}
+	payload.Properties.SetMaintenanceStartTimeAsTime(startTime)
+
+	result, err := client.Update(ctx, *id, payload)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rescheduling %s: %+v", id, err))
+		return
+	}
+	if err := m.PollWithProgress(ctx, response, fmt.Sprintf("rescheduling maintenance %s on %s", id.MaintenanceName, id.FlexibleServerName), result.Poller); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rescheduling maintenance %s on %s completed", id.MaintenanceName, id.FlexibleServerName),
+	})
+}
+
+func (m *MySQLFlexibleServerMaintenanceRescheduleAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
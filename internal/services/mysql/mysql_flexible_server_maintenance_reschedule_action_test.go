@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type MySQLFlexibleServerMaintenanceRescheduleAction struct{}
+
+// maintenances are scheduled by the service and can't be created on demand, so this test targets an existing one
+func TestAccMySQLFlexibleServerMaintenanceRescheduleAction_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_MYSQL_MAINTENANCE_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MYSQL_MAINTENANCE_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_maintenance_reschedule", "test")
+	a := MySQLFlexibleServerMaintenanceRescheduleAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *MySQLFlexibleServerMaintenanceRescheduleAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "terraform_data" "trigger" {
+  input = "%[1]d"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_mysql_flexible_server_maintenance_reschedule.test]
+    }
+  }
+}
+
+action "azurerm_mysql_flexible_server_maintenance_reschedule" "test" {
+  config {
+    maintenance_id = "%[2]s"
+    start_time     = "%[3]s"
+  }
+}
+`, data.RandomInteger, os.Getenv("ARM_TEST_MYSQL_MAINTENANCE_ID"), time.Now().UTC().Add(72*time.Hour).Format(time.RFC3339))
+}
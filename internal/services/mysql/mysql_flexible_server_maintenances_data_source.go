@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/maintenances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MySQLFlexibleServerMaintenancesDataSource struct{}
+
+var _ sdk.DataSource = MySQLFlexibleServerMaintenancesDataSource{}
+
+type MySQLFlexibleServerMaintenancesDataSourceModel struct {
+	ServerId     string                                            `tfschema:"server_id"`
+	Maintenances []MySQLFlexibleServerMaintenancesMaintenanceModel `tfschema:"maintenances"`
+}
+
+type MySQLFlexibleServerMaintenancesMaintenanceModel struct {
+	Id                       string `tfschema:"id"`
+	Name                     string `tfschema:"name"`
+	Title                    string `tfschema:"title"`
+	Description              string `tfschema:"description"`
+	MaintenanceType          string `tfschema:"maintenance_type"`
+	State                    string `tfschema:"state"`
+	StartTime                string `tfschema:"start_time"`
+	EndTime                  string `tfschema:"end_time"`
+	ExecutionStartTime       string `tfschema:"execution_start_time"`
+	ExecutionEndTime         string `tfschema:"execution_end_time"`
+	AvailableScheduleMinTime string `tfschema:"available_schedule_min_time"`
+	AvailableScheduleMaxTime string `tfschema:"available_schedule_max_time"`
+}
+
+func (r MySQLFlexibleServerMaintenancesDataSource) ResourceType() string {
+	return "azurerm_mysql_flexible_server_maintenances"
+}
+
+func (r MySQLFlexibleServerMaintenancesDataSource) ModelObject() interface{} {
+	return &MySQLFlexibleServerMaintenancesDataSourceModel{}
+}
+
+func (r MySQLFlexibleServerMaintenancesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: maintenances.ValidateFlexibleServerID,
+		},
+	}
+}
+
+func (r MySQLFlexibleServerMaintenancesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"maintenances": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"title": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"maintenance_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_schedule_min_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_schedule_max_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MySQLFlexibleServerMaintenancesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MySQL.FlexibleServers.Maintenances
+
+			var state MySQLFlexibleServerMaintenancesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := maintenances.ParseFlexibleServerID(state.ServerId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing maintenances for %s: %+v", id, err)
+			}
+
+			state.Maintenances = flattenMySQLFlexibleServerMaintenances(resp.Items)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenMySQLFlexibleServerMaintenances(input []maintenances.Maintenance) []MySQLFlexibleServerMaintenancesMaintenanceModel {
+	output := make([]MySQLFlexibleServerMaintenancesMaintenanceModel, 0, len(input))
+
+	for _, item := range input {
+		props := item.Properties
+		output = append(output, MySQLFlexibleServerMaintenancesMaintenanceModel{
+			Id:                       pointer.From(item.Id),
+			Name:                     pointer.From(item.Name),
+			Title:                    pointer.From(props.MaintenanceTitle),
+			Description:              pointer.From(props.MaintenanceDescription),
+			MaintenanceType:          string(pointer.From(props.MaintenanceType)),
+			State:                    string(pointer.From(props.MaintenanceState)),
+			StartTime:                pointer.From(props.MaintenanceStartTime),
+			EndTime:                  pointer.From(props.MaintenanceEndTime),
+			ExecutionStartTime:       pointer.From(props.MaintenanceExecutionStartTime),
+			ExecutionEndTime:         pointer.From(props.MaintenanceExecutionEndTime),
+			AvailableScheduleMinTime: pointer.From(props.MaintenanceAvailableScheduleMinTime),
+			AvailableScheduleMaxTime: pointer.From(props.MaintenanceAvailableScheduleMaxTime),
+		})
+	}
+
+	return output
+}
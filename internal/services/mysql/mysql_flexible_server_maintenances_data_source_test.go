@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MySQLFlexibleServerMaintenancesDataSource struct{}
+
+func TestAccMySQLFlexibleServerMaintenancesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mysql_flexible_server_maintenances", "test")
+	d := MySQLFlexibleServerMaintenancesDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("server_id").Exists(),
+				check.That(data.ResourceName).Key("maintenances.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d MySQLFlexibleServerMaintenancesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mysql_flexible_server_maintenances" "test" {
+  server_id = azurerm_mysql_flexible_server.test.id
+}
+`, MySQLFlexibleServerBackupResource{}.template(data))
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverrestart"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverstart"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverstop"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MySQLFlexibleServerPowerAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MySQLFlexibleServerPowerAction{}
+
+func newMySQLFlexibleServerPowerAction() action.Action {
+	return &MySQLFlexibleServerPowerAction{}
+}
+
+type MySQLFlexibleServerPowerActionModel struct {
+	FlexibleServerId    types.String `tfsdk:"flexible_server_id"`
+	Action              types.String `tfsdk:"power_action"`
+	RestartWithFailover types.Bool   `tfsdk:"restart_with_failover"`
+	MaxFailoverSeconds  types.Int64  `tfsdk:"max_failover_seconds"`
+	Timeouts            types.Object `tfsdk:"timeouts"`
+}
+
+func (m *MySQLFlexibleServerPowerAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"flexible_server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the MySQL Flexible Server on which to perform the action.",
+				MarkdownDescription: "The ID of the MySQL Flexible Server on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The power state action to take on this MySQL Flexible Server. Possible values include `restart`, `start`, and `stop`.",
+				MarkdownDescription: "The power state action to take on this MySQL Flexible Server. Possible values include `restart`, `start`, and `stop`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"restart",
+						"start",
+						"stop",
+					),
+				},
+			},
+
+			"restart_with_failover": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether the restart should fail over to the standby server. Only applicable when `power_action` is `restart` and High Availability is enabled.",
+				MarkdownDescription: "Whether the restart should fail over to the standby server. Only applicable when `power_action` is `restart` and High Availability is enabled.",
+			},
+
+			"max_failover_seconds": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of seconds to wait for the failover to complete. Only applicable when `restart_with_failover` is `true`.",
+				MarkdownDescription: "The maximum number of seconds to wait for the failover to complete. Only applicable when `restart_with_failover` is `true`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sdk.ActionTimeoutsBlock(),
+		},
+	}
+}
+
+func (m *MySQLFlexibleServerPowerAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mysql_flexible_server_power"
+}
+
+func (m *MySQLFlexibleServerPowerAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MySQL.FlexibleServers
+
+	model := MySQLFlexibleServerPowerActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := m.InvokeTimeout(ctx, model.Timeouts, time.Minute*60)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(model.FlexibleServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	powerAction := model.Action.ValueString()
+
+	if powerAction != "restart" && (!model.RestartWithFailover.IsNull() || !model.MaxFailoverSeconds.IsNull()) {
+		sdk.SetResponseErrorDiagnostic(response, "invalid configuration", "`restart_with_failover` and `max_failover_seconds` can only be specified when `power_action` is `restart`")
+		return
+	}
+
+	if !model.MaxFailoverSeconds.IsNull() && !model.RestartWithFailover.ValueBool() {
+		sdk.SetResponseErrorDiagnostic(response, "invalid configuration", "`max_failover_seconds` can only be specified when `restart_with_failover` is `true`")
+		return
+	}
+
+	if model.MaxFailoverSeconds.ValueInt64() < 0 {
+		sdk.SetResponseErrorDiagnostic(response, "invalid configuration", "`max_failover_seconds` must not be negative")
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", powerAction, id.FlexibleServerName),
+	})
+
+	switch powerAction {
+	case "restart":
+		restartId := serverrestart.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+		payload := serverrestart.ServerRestartParameter{
+			RestartWithFailover: pointer.To(serverrestart.EnableStatusEnumDisabled),
+		}
+		if model.RestartWithFailover.ValueBool() {
+			payload.RestartWithFailover = pointer.To(serverrestart.EnableStatusEnumEnabled)
+		}
+		if !model.MaxFailoverSeconds.IsNull() {
+			payload.MaxFailoverSeconds = model.MaxFailoverSeconds.ValueInt64Pointer()
+		}
+
+		result, err := client.ServerRestart.ServersRestart(ctx, restartId, payload)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+		if err := m.PollWithProgress(ctx, response, fmt.Sprintf("restarting %s", id.FlexibleServerName), result.Poller); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+
+	case "start":
+		startId := serverstart.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+		result, err := client.ServerStart.ServersStart(ctx, startId)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting %s: %+v", id, err))
+			return
+		}
+		if err := m.PollWithProgress(ctx, response, fmt.Sprintf("starting %s", id.FlexibleServerName), result.Poller); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+
+	case "stop":
+		stopId := serverstop.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+		result, err := client.ServerStop.ServersStop(ctx, stopId)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("stopping %s: %+v", id, err))
+			return
+		}
+		if err := m.PollWithProgress(ctx, response, fmt.Sprintf("stopping %s", id.FlexibleServerName), result.Poller); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", powerAction, id.FlexibleServerName),
+	})
+}
+
+func (m *MySQLFlexibleServerPowerAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
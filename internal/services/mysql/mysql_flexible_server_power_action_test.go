@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type MySQLFlexibleServerPowerAction struct{}
+
+func TestAccMySQLFlexibleServerPowerAction_restart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_power", "test")
+	a := MySQLFlexibleServerPowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.restart(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccMySQLFlexibleServerPowerAction_stopAndStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_power", "test")
+	a := MySQLFlexibleServerPowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.stopAndStart(data, "create"),
+			},
+			{
+				Config: a.stopAndStart(data, "update"),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *MySQLFlexibleServerPowerAction) restart(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_mysql_flexible_server.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_mysql_flexible_server_power.restart]
+    }
+  }
+}
+
+action "azurerm_mysql_flexible_server_power" "restart" {
+  config {
+    flexible_server_id = azurerm_mysql_flexible_server.test.id
+    power_action       = "restart"
+
+    timeouts {
+      invoke = "30m"
+    }
+  }
+}
+`, a.template(data))
+}
+
+func (a *MySQLFlexibleServerPowerAction) stopAndStart(data acceptance.TestData, tagVal string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%s"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update]
+      actions = [action.azurerm_mysql_flexible_server_power.stop]
+    }
+
+    action_trigger {
+      events  = [after_update]
+      actions = [action.azurerm_mysql_flexible_server_power.start]
+    }
+  }
+}
+
+action "azurerm_mysql_flexible_server_power" "stop" {
+  config {
+    flexible_server_id = azurerm_mysql_flexible_server.test.id
+    power_action       = "stop"
+  }
+}
+
+action "azurerm_mysql_flexible_server_power" "start" {
+  config {
+    flexible_server_id = azurerm_mysql_flexible_server.test.id
+    power_action       = "start"
+  }
+}
+`, a.template(data), tagVal)
+}
+
+func (a *MySQLFlexibleServerPowerAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mysql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "_admin_Terraform_892123456789312"
+  administrator_password = "QAZwsx123"
+  sku_name               = "B_Standard_B1ms"
+}
+`, data.RandomInteger, data.Locations.Ternary)
+}
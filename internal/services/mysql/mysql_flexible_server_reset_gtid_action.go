@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverresetgtid"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MySQLFlexibleServerResetGtidAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MySQLFlexibleServerResetGtidAction{}
+
+func newMySQLFlexibleServerResetGtidAction() action.Action {
+	return &MySQLFlexibleServerResetGtidAction{}
+}
+
+type MySQLFlexibleServerResetGtidActionModel struct {
+	FlexibleServerId types.String `tfsdk:"flexible_server_id"`
+	GtidSet          types.String `tfsdk:"gtid_set"`
+	Timeouts         types.Object `tfsdk:"timeouts"`
+}
+
+func (m *MySQLFlexibleServerResetGtidAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"flexible_server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the MySQL Flexible Server on which to reset the GTID.",
+				MarkdownDescription: "The ID of the MySQL Flexible Server on which to reset the GTID.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"gtid_set": schema.StringAttribute{
+				Required:            true,
+				Description:         "The GTID set to reset the MySQL Flexible Server to, for example `3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5`.",
+				MarkdownDescription: "The GTID set to reset the MySQL Flexible Server to, for example `3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": sdk.ActionTimeoutsBlock(),
+		},
+	}
+}
+
+func (m *MySQLFlexibleServerResetGtidAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mysql_flexible_server_reset_gtid"
+}
+
+func (m *MySQLFlexibleServerResetGtidAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MySQL.FlexibleServers.ServerResetGtid
+
+	model := MySQLFlexibleServerResetGtidActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := m.InvokeTimeout(ctx, model.Timeouts, time.Minute*30)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	serverId, err := servers.ParseFlexibleServerID(model.FlexibleServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	id := serverresetgtid.NewFlexibleServerID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.FlexibleServerName)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("resetting the GTID on %s", id.FlexibleServerName),
+	})
+
+	payload := serverresetgtid.ServerGtidSetParameter{
+		GtidSet: model.GtidSet.ValueStringPointer(),
+	}
+
+	result, err := client.ServersResetGtid(ctx, id, payload)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("resetting the GTID on %s: %+v", id, err))
+		return
+	}
+	if err := m.PollWithProgress(ctx, response, fmt.Sprintf("resetting the GTID on %s", id.FlexibleServerName), result.Poller); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("resetting the GTID on %s completed", id.FlexibleServerName),
+	})
+}
+
+func (m *MySQLFlexibleServerResetGtidAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
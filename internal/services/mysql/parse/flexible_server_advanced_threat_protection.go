@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FlexibleServerAdvancedThreatProtectionId struct {
+	SubscriptionId                      string
+	ResourceGroup                       string
+	FlexibleServerName                  string
+	AdvancedThreatProtectionSettingName string
+}
+
+func NewFlexibleServerAdvancedThreatProtectionID(subscriptionId, resourceGroup, flexibleServerName, advancedThreatProtectionSettingName string) FlexibleServerAdvancedThreatProtectionId {
+	return FlexibleServerAdvancedThreatProtectionId{
+		SubscriptionId:                      subscriptionId,
+		ResourceGroup:                       resourceGroup,
+		FlexibleServerName:                  flexibleServerName,
+		AdvancedThreatProtectionSettingName: advancedThreatProtectionSettingName,
+	}
+}
+
+func (id FlexibleServerAdvancedThreatProtectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Advanced Threat Protection Setting Name %q", id.AdvancedThreatProtectionSettingName),
+		fmt.Sprintf("Flexible Server Name %q", id.FlexibleServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Flexible Server Advanced Threat Protection", segmentsStr)
+}
+
+func (id FlexibleServerAdvancedThreatProtectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforMySQL/flexibleServers/%s/advancedThreatProtectionSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.AdvancedThreatProtectionSettingName)
+}
+
+// FlexibleServerAdvancedThreatProtectionID parses a FlexibleServerAdvancedThreatProtection ID into an FlexibleServerAdvancedThreatProtectionId struct
+func FlexibleServerAdvancedThreatProtectionID(input string) (*FlexibleServerAdvancedThreatProtectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an FlexibleServerAdvancedThreatProtection ID: %+v", input, err)
+	}
+
+	resourceId := FlexibleServerAdvancedThreatProtectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
+		return nil, err
+	}
+	if resourceId.AdvancedThreatProtectionSettingName, err = id.PopSegment("advancedThreatProtectionSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
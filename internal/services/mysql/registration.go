@@ -4,6 +4,9 @@
 package mysql
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,6 +16,7 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistration                   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkServiceRegistration               = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -20,12 +24,17 @@ func (r Registration) AssociatedGitHubLabel() string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		MySQLFlexibleServerBackupsDataSource{},
+		MySQLFlexibleServerMaintenancesDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		MySQLFlexibleServerAdministratorResource{},
+		MySQLFlexibleServerAdvancedThreatProtectionResource{},
+		MySQLFlexibleServerBackupResource{},
 	}
 }
 
@@ -61,3 +70,28 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 	return resources
 }
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newMySQLFlexibleServerBackupAndExportAction,
+		newMySQLFlexibleServerMaintenanceRescheduleAction,
+		newMySQLFlexibleServerPowerAction,
+		newMySQLFlexibleServerResetGtidAction,
+	}
+}
+
+func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
+	return []sdk.FrameworkWrappedResource{}
+}
+
+func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
+	return []sdk.FrameworkWrappedDataSource{}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{}
+}
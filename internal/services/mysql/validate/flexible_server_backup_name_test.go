@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFlexibleServerBackupName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "backup-1",
+			expected: true,
+		},
+		{
+			input:    "Backup_2024.01",
+			expected: true,
+		},
+		{
+			input:    "backup 1",
+			expected: false,
+		},
+		{
+			input:    "backup@1",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("s", 128),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("s", 129),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := FlexibleServerBackupName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitarptable"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExpressRouteCircuitArpTableDataSource struct{}
+
+var _ sdk.DataSource = ExpressRouteCircuitArpTableDataSource{}
+
+type ExpressRouteCircuitArpTableDataSourceModel struct {
+	ExpressRouteCircuitPeeringId string                             `tfschema:"express_route_circuit_peering_id"`
+	DevicePath                   string                             `tfschema:"device_path"`
+	Entries                      []ExpressRouteCircuitArpEntryModel `tfschema:"entry"`
+}
+
+type ExpressRouteCircuitArpEntryModel struct {
+	Age        int64  `tfschema:"age"`
+	Interface  string `tfschema:"interface"`
+	IPAddress  string `tfschema:"ip_address"`
+	MacAddress string `tfschema:"mac_address"`
+}
+
+func (ExpressRouteCircuitArpTableDataSource) ResourceType() string {
+	return "azurerm_express_route_circuit_arp_table"
+}
+
+func (ExpressRouteCircuitArpTableDataSource) ModelObject() interface{} {
+	return &ExpressRouteCircuitArpTableDataSourceModel{}
+}
+
+func (ExpressRouteCircuitArpTableDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"express_route_circuit_peering_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateExpressRouteCircuitPeeringID,
+		},
+
+		"device_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(expressRouteCircuitDevicePaths(), false),
+		},
+	}
+}
+
+func (ExpressRouteCircuitArpTableDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"entry": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"age": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"interface": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"mac_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ExpressRouteCircuitArpTableDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCircuitArpTable
+
+			var state ExpressRouteCircuitArpTableDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			peeringId, err := commonids.ParseExpressRouteCircuitPeeringID(state.ExpressRouteCircuitPeeringId)
+			if err != nil {
+				return err
+			}
+
+			id := expressroutecircuitarptable.NewArpTableID(peeringId.SubscriptionId, peeringId.ResourceGroupName, peeringId.CircuitName, peeringId.PeeringName, state.DevicePath)
+
+			future, err := client.ExpressRouteCircuitsListArpTable(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing %s: %+v", id, err)
+			}
+			if err := future.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for listing of %s: %+v", id, err)
+			}
+
+			var result struct {
+				Value *[]expressroutecircuitarptable.ExpressRouteCircuitArpTable `json:"value"`
+			}
+			if err := future.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving result of listing %s: %+v", id, err)
+			}
+
+			entries := make([]ExpressRouteCircuitArpEntryModel, 0)
+			if result.Value != nil {
+				for _, item := range *result.Value {
+					entries = append(entries, ExpressRouteCircuitArpEntryModel{
+						Age:        pointer.From(item.Age),
+						Interface:  pointer.From(item.Interface),
+						IPAddress:  pointer.From(item.IPAddress),
+						MacAddress: pointer.From(item.MacAddress),
+					})
+				}
+			}
+			state.Entries = entries
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// expressRouteCircuitDevicePaths returns the device paths of an ExpressRoute Circuit which runtime tables can be retrieved for
+func expressRouteCircuitDevicePaths() []string {
+	return []string{
+		"primary",
+		"secondary",
+	}
+}
@@ -44,6 +44,11 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"azurePrivatePeeringDataSource": testAccDataSourceExpressRouteCircuitPeering_privatePeering,
 			"azurePrivatePeeringWithUpdate": testAccExpressRouteCircuitPeering_azurePrivatePeeringWithCircuitUpdate,
 			"requiresImport":                testAccExpressRouteCircuitPeering_requiresImport,
+			"arpTableDataSource":            testAccDataSourceExpressRouteCircuitArpTable_basic,
+			"routesTableDataSource":         testAccDataSourceExpressRouteCircuitRoutesTable_basic,
+			"routesTableSummaryDataSource":  testAccDataSourceExpressRouteCircuitRoutesTableSummary_basic,
+			"circuitStatsDataSource":        testAccDataSourceExpressRouteCircuitStats_circuit,
+			"peeringStatsDataSource":        testAccDataSourceExpressRouteCircuitStats_peering,
 		},
 		"MicrosoftPeering": {
 			"microsoftPeering":                    testAccExpressRouteCircuitPeering_microsoftPeering,
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitroutestable"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExpressRouteCircuitRoutesTableDataSource struct{}
+
+var _ sdk.DataSource = ExpressRouteCircuitRoutesTableDataSource{}
+
+type ExpressRouteCircuitRoutesTableDataSourceModel struct {
+	ExpressRouteCircuitPeeringId string                          `tfschema:"express_route_circuit_peering_id"`
+	DevicePath                   string                          `tfschema:"device_path"`
+	Routes                       []ExpressRouteCircuitRouteModel `tfschema:"route"`
+}
+
+type ExpressRouteCircuitRouteModel struct {
+	LocalPreference string `tfschema:"local_preference"`
+	Network         string `tfschema:"network"`
+	NextHop         string `tfschema:"next_hop"`
+	Path            string `tfschema:"path"`
+	Weight          int64  `tfschema:"weight"`
+}
+
+func (ExpressRouteCircuitRoutesTableDataSource) ResourceType() string {
+	return "azurerm_express_route_circuit_routes_table"
+}
+
+func (ExpressRouteCircuitRoutesTableDataSource) ModelObject() interface{} {
+	return &ExpressRouteCircuitRoutesTableDataSourceModel{}
+}
+
+func (ExpressRouteCircuitRoutesTableDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"express_route_circuit_peering_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateExpressRouteCircuitPeeringID,
+		},
+
+		"device_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(expressRouteCircuitDevicePaths(), false),
+		},
+	}
+}
+
+func (ExpressRouteCircuitRoutesTableDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"route": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"local_preference": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"network": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"next_hop": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"path": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"weight": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ExpressRouteCircuitRoutesTableDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCircuitRoutesTable
+
+			var state ExpressRouteCircuitRoutesTableDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			peeringId, err := commonids.ParseExpressRouteCircuitPeeringID(state.ExpressRouteCircuitPeeringId)
+			if err != nil {
+				return err
+			}
+
+			id := expressroutecircuitroutestable.NewPeeringRouteTableID(peeringId.SubscriptionId, peeringId.ResourceGroupName, peeringId.CircuitName, peeringId.PeeringName, state.DevicePath)
+
+			future, err := client.ExpressRouteCircuitsListRoutesTable(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing %s: %+v", id, err)
+			}
+			if err := future.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for listing of %s: %+v", id, err)
+			}
+
+			var result struct {
+				Value *[]expressroutecircuitroutestable.ExpressRouteCircuitRoutesTable `json:"value"`
+			}
+			if err := future.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving result of listing %s: %+v", id, err)
+			}
+
+			routes := make([]ExpressRouteCircuitRouteModel, 0)
+			if result.Value != nil {
+				for _, item := range *result.Value {
+					routes = append(routes, ExpressRouteCircuitRouteModel{
+						LocalPreference: pointer.From(item.LocPrf),
+						Network:         pointer.From(item.Network),
+						NextHop:         pointer.From(item.NextHop),
+						Path:            pointer.From(item.Path),
+						Weight:          pointer.From(item.Weight),
+					})
+				}
+			}
+			state.Routes = routes
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteCircuitRoutesTableDataSource struct{}
+
+func testAccDataSourceExpressRouteCircuitRoutesTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_routes_table", "test")
+	d := ExpressRouteCircuitRoutesTableDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d ExpressRouteCircuitRoutesTableDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_routes_table" "test" {
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+  device_path                      = "primary"
+}
+`, ExpressRouteCircuitPeeringResource{}.privatePeering(data))
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitroutestablesummary"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExpressRouteCircuitRoutesTableSummaryDataSource struct{}
+
+var _ sdk.DataSource = ExpressRouteCircuitRoutesTableSummaryDataSource{}
+
+type ExpressRouteCircuitRoutesTableSummaryDataSourceModel struct {
+	ExpressRouteCircuitPeeringId string                                        `tfschema:"express_route_circuit_peering_id"`
+	DevicePath                   string                                        `tfschema:"device_path"`
+	Neighbors                    []ExpressRouteCircuitRoutesTableNeighborModel `tfschema:"neighbor"`
+}
+
+type ExpressRouteCircuitRoutesTableNeighborModel struct {
+	AsNumber                int64  `tfschema:"as_number"`
+	IPAddress               string `tfschema:"ip_address"`
+	PrefixesReceivedOrState string `tfschema:"prefixes_received_or_state"`
+	UpDown                  string `tfschema:"up_down"`
+	BgpVersion              int64  `tfschema:"bgp_version"`
+}
+
+func (ExpressRouteCircuitRoutesTableSummaryDataSource) ResourceType() string {
+	return "azurerm_express_route_circuit_routes_table_summary"
+}
+
+func (ExpressRouteCircuitRoutesTableSummaryDataSource) ModelObject() interface{} {
+	return &ExpressRouteCircuitRoutesTableSummaryDataSourceModel{}
+}
+
+func (ExpressRouteCircuitRoutesTableSummaryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"express_route_circuit_peering_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateExpressRouteCircuitPeeringID,
+		},
+
+		"device_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(expressRouteCircuitDevicePaths(), false),
+		},
+	}
+}
+
+func (ExpressRouteCircuitRoutesTableSummaryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"neighbor": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"as_number": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"bgp_version": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"prefixes_received_or_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"up_down": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ExpressRouteCircuitRoutesTableSummaryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCircuitRoutesTableSummary
+
+			var state ExpressRouteCircuitRoutesTableSummaryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			peeringId, err := commonids.ParseExpressRouteCircuitPeeringID(state.ExpressRouteCircuitPeeringId)
+			if err != nil {
+				return err
+			}
+
+			id := expressroutecircuitroutestablesummary.NewRouteTablesSummaryID(peeringId.SubscriptionId, peeringId.ResourceGroupName, peeringId.CircuitName, peeringId.PeeringName, state.DevicePath)
+
+			future, err := client.ExpressRouteCircuitsListRoutesTableSummary(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing %s: %+v", id, err)
+			}
+			if err := future.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for listing of %s: %+v", id, err)
+			}
+
+			var result struct {
+				Value *[]expressroutecircuitroutestablesummary.ExpressRouteCircuitRoutesTableSummary `json:"value"`
+			}
+			if err := future.Poller.FinalResult(&result); err != nil {
+				return fmt.Errorf("retrieving result of listing %s: %+v", id, err)
+			}
+
+			neighbors := make([]ExpressRouteCircuitRoutesTableNeighborModel, 0)
+			if result.Value != nil {
+				for _, item := range *result.Value {
+					neighbors = append(neighbors, ExpressRouteCircuitRoutesTableNeighborModel{
+						AsNumber:                pointer.From(item.As),
+						BgpVersion:              pointer.From(item.V),
+						IPAddress:               pointer.From(item.Neighbor),
+						PrefixesReceivedOrState: pointer.From(item.StatePfxRcd),
+						UpDown:                  pointer.From(item.UpDown),
+					})
+				}
+			}
+			state.Neighbors = neighbors
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitstats"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ExpressRouteCircuitStatsDataSource struct{}
+
+var _ sdk.DataSource = ExpressRouteCircuitStatsDataSource{}
+
+type ExpressRouteCircuitStatsDataSourceModel struct {
+	ExpressRouteCircuitId        string `tfschema:"express_route_circuit_id"`
+	ExpressRouteCircuitPeeringId string `tfschema:"express_route_circuit_peering_id"`
+	PrimaryBytesIn               int64  `tfschema:"primary_bytes_in"`
+	PrimaryBytesOut              int64  `tfschema:"primary_bytes_out"`
+	SecondaryBytesIn             int64  `tfschema:"secondary_bytes_in"`
+	SecondaryBytesOut            int64  `tfschema:"secondary_bytes_out"`
+}
+
+func (ExpressRouteCircuitStatsDataSource) ResourceType() string {
+	return "azurerm_express_route_circuit_stats"
+}
+
+func (ExpressRouteCircuitStatsDataSource) ModelObject() interface{} {
+	return &ExpressRouteCircuitStatsDataSourceModel{}
+}
+
+func (ExpressRouteCircuitStatsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"express_route_circuit_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: expressroutecircuitstats.ValidateExpressRouteCircuitID,
+			ExactlyOneOf: []string{"express_route_circuit_id", "express_route_circuit_peering_id"},
+		},
+
+		"express_route_circuit_peering_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateExpressRouteCircuitPeeringID,
+			ExactlyOneOf: []string{"express_route_circuit_id", "express_route_circuit_peering_id"},
+		},
+	}
+}
+
+func (ExpressRouteCircuitStatsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"primary_bytes_in": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"primary_bytes_out": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"secondary_bytes_in": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"secondary_bytes_out": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (ExpressRouteCircuitStatsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCircuitStats
+
+			var state ExpressRouteCircuitStatsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var stats *expressroutecircuitstats.ExpressRouteCircuitStats
+			if state.ExpressRouteCircuitPeeringId != "" {
+				id, err := commonids.ParseExpressRouteCircuitPeeringID(state.ExpressRouteCircuitPeeringId)
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.ExpressRouteCircuitsGetPeeringStats(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving stats for %s: %+v", id, err)
+				}
+				stats = resp.Model
+
+				metadata.SetID(id)
+			} else {
+				id, err := expressroutecircuitstats.ParseExpressRouteCircuitID(state.ExpressRouteCircuitId)
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.ExpressRouteCircuitsGetStats(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving stats for %s: %+v", id, err)
+				}
+				stats = resp.Model
+
+				metadata.SetID(id)
+			}
+
+			if stats != nil {
+				state.PrimaryBytesIn = pointer.From(stats.PrimarybytesIn)
+				state.PrimaryBytesOut = pointer.From(stats.PrimarybytesOut)
+				state.SecondaryBytesIn = pointer.From(stats.SecondarybytesIn)
+				state.SecondaryBytesOut = pointer.From(stats.SecondarybytesOut)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
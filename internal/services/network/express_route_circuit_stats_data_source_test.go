@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteCircuitStatsDataSource struct{}
+
+func testAccDataSourceExpressRouteCircuitStats_circuit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_stats", "test")
+	d := ExpressRouteCircuitStatsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.circuit(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("primary_bytes_in").Exists(),
+				check.That(data.ResourceName).Key("primary_bytes_out").Exists(),
+				check.That(data.ResourceName).Key("secondary_bytes_in").Exists(),
+				check.That(data.ResourceName).Key("secondary_bytes_out").Exists(),
+			),
+		},
+	})
+}
+
+func testAccDataSourceExpressRouteCircuitStats_peering(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_stats", "test")
+	d := ExpressRouteCircuitStatsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.peering(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("primary_bytes_in").Exists(),
+				check.That(data.ResourceName).Key("secondary_bytes_in").Exists(),
+			),
+		},
+	})
+}
+
+func (d ExpressRouteCircuitStatsDataSource) circuit(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_stats" "test" {
+  express_route_circuit_id = azurerm_express_route_circuit.test.id
+
+  depends_on = [azurerm_express_route_circuit_peering.test]
+}
+`, ExpressRouteCircuitPeeringResource{}.privatePeering(data))
+}
+
+func (d ExpressRouteCircuitStatsDataSource) peering(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_stats" "test" {
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+}
+`, ExpressRouteCircuitPeeringResource{}.privatePeering(data))
+}
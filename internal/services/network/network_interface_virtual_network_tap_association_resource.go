@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworktap"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+type NetworkInterfaceVirtualNetworkTapAssociationResource struct{}
+
+type NetworkInterfaceVirtualNetworkTapAssociationResourceModel struct {
+	Name                string `tfschema:"name"`
+	NetworkInterfaceId  string `tfschema:"network_interface_id"`
+	VirtualNetworkTapId string `tfschema:"virtual_network_tap_id"`
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkinterfaces.ValidateTapConfigurationID
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) ResourceType() string {
+	return "azurerm_network_interface_virtual_network_tap_association"
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) ModelObject() interface{} {
+	return &NetworkInterfaceVirtualNetworkTapAssociationResourceModel{}
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+
+		"virtual_network_tap_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualnetworktap.ValidateVirtualNetworkTapID,
+		},
+	}
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var config NetworkInterfaceVirtualNetworkTapAssociationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkInterfaceId, err := commonids.ParseNetworkInterfaceID(config.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+			defer locks.UnlockByName(networkInterfaceId.NetworkInterfaceName, networkInterfaceResourceName)
+
+			id := networkinterfaces.NewTapConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroupName, networkInterfaceId.NetworkInterfaceName, config.Name)
+
+			existing, err := client.NetworkInterfaceTapConfigurationsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkinterfaces.NetworkInterfaceTapConfiguration{
+				Name: pointer.To(config.Name),
+				Properties: &networkinterfaces.NetworkInterfaceTapConfigurationPropertiesFormat{
+					VirtualNetworkTap: &networkinterfaces.VirtualNetworkTap{
+						Id: pointer.To(config.VirtualNetworkTapId),
+					},
+				},
+			}
+
+			if err := client.NetworkInterfaceTapConfigurationsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseTapConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NetworkInterfaceTapConfigurationsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NetworkInterfaceVirtualNetworkTapAssociationResourceModel{
+				Name:               id.TapConfigurationName,
+				NetworkInterfaceId: commonids.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroupName, id.NetworkInterfaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if tap := model.Properties.VirtualNetworkTap; tap != nil && tap.Id != nil {
+					tapId, err := virtualnetworktap.ParseVirtualNetworkTapIDInsensitively(*tap.Id)
+					if err != nil {
+						return err
+					}
+					state.VirtualNetworkTapId = tapId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (NetworkInterfaceVirtualNetworkTapAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseTapConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+			defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+			if err := client.NetworkInterfaceTapConfigurationsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
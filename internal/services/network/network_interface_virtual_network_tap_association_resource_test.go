@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkInterfaceVirtualNetworkTapAssociationResource struct{}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceVirtualNetworkTapAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_virtual_network_tap_association", "test")
+	r := NetworkInterfaceVirtualNetworkTapAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkinterfaces.ParseTapConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkInterfaces.NetworkInterfaceTapConfigurationsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_interface" "source" {
+  name                = "acctestni-src-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_virtual_network_tap_association" "test" {
+  name                   = "acctest-tapconfig-%[2]d"
+  network_interface_id   = azurerm_network_interface.source.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, VirtualNetworkTapResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkInterfaceVirtualNetworkTapAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_virtual_network_tap_association" "import" {
+  name                   = azurerm_network_interface_virtual_network_tap_association.test.name
+  network_interface_id   = azurerm_network_interface_virtual_network_tap_association.test.network_interface_id
+  virtual_network_tap_id = azurerm_network_interface_virtual_network_tap_association.test.virtual_network_tap_id
+}
+`, r.basic(data))
+}
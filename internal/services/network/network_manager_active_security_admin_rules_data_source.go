@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/adminrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanageractiveconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerActiveSecurityAdminRulesDataSource struct{}
+
+var _ sdk.DataSource = ManagerActiveSecurityAdminRulesDataSource{}
+
+type ManagerActiveSecurityAdminRulesDataSourceModel struct {
+	NetworkManagerId string                                `tfschema:"network_manager_id"`
+	Regions          []string                              `tfschema:"regions"`
+	Rules            []ManagerActiveSecurityAdminRuleModel `tfschema:"rule"`
+}
+
+type ManagerActiveSecurityAdminRuleModel struct {
+	Id                            string                   `tfschema:"id"`
+	Kind                          string                   `tfschema:"kind"`
+	Action                        string                   `tfschema:"action"`
+	CommitTime                    string                   `tfschema:"commit_time"`
+	ConfigurationDescription      string                   `tfschema:"configuration_description"`
+	ConfigurationGroupIds         []string                 `tfschema:"configuration_group_ids"`
+	Description                   string                   `tfschema:"description"`
+	DestinationPortRanges         []string                 `tfschema:"destination_port_ranges"`
+	Destinations                  []AddressPrefixItemModel `tfschema:"destination"`
+	Direction                     string                   `tfschema:"direction"`
+	Flag                          string                   `tfschema:"flag"`
+	Priority                      int64                    `tfschema:"priority"`
+	Protocol                      string                   `tfschema:"protocol"`
+	Region                        string                   `tfschema:"region"`
+	RuleCollectionDescription     string                   `tfschema:"rule_collection_description"`
+	RuleCollectionNetworkGroupIds []string                 `tfschema:"rule_collection_network_group_ids"`
+	SourcePortRanges              []string                 `tfschema:"source_port_ranges"`
+	Sources                       []AddressPrefixItemModel `tfschema:"source"`
+}
+
+func (r ManagerActiveSecurityAdminRulesDataSource) ResourceType() string {
+	return "azurerm_network_manager_active_security_admin_rules"
+}
+
+func (r ManagerActiveSecurityAdminRulesDataSource) ModelObject() interface{} {
+	return &ManagerActiveSecurityAdminRulesDataSourceModel{}
+}
+
+func (r ManagerActiveSecurityAdminRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkmanageractiveconfigurations.ValidateNetworkManagerID,
+		},
+
+		"regions": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ManagerActiveSecurityAdminRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	ruleSchema := managerSecurityAdminRuleDataSourceSchema()
+	ruleSchema["commit_time"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+	ruleSchema["region"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: ruleSchema,
+			},
+		},
+	}
+}
+
+func (r ManagerActiveSecurityAdminRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkManagerActiveConfigurations
+
+			var state ManagerActiveSecurityAdminRulesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkmanageractiveconfigurations.ParseNetworkManagerID(state.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			parameters := networkmanageractiveconfigurations.ActiveConfigurationParameter{}
+			if len(state.Regions) > 0 {
+				regions := make([]string, 0, len(state.Regions))
+				for _, region := range state.Regions {
+					regions = append(regions, location.Normalize(region))
+				}
+				parameters.Regions = pointer.To(regions)
+			}
+
+			rules := make([]ManagerActiveSecurityAdminRuleModel, 0)
+			for {
+				resp, err := client.ListActiveSecurityAdminRules(ctx, *id, parameters)
+				if err != nil {
+					return fmt.Errorf("listing active security admin rules for %s: %+v", id, err)
+				}
+
+				if resp.Model == nil {
+					break
+				}
+
+				rules = append(rules, flattenManagerActiveSecurityAdminRules(resp.Model.Value)...)
+
+				if pointer.From(resp.Model.SkipToken) == "" {
+					break
+				}
+				parameters.SkipToken = resp.Model.SkipToken
+			}
+
+			state.Rules = rules
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenManagerActiveSecurityAdminRules(inputList *[]networkmanageractiveconfigurations.ActiveBaseSecurityAdminRule) []ManagerActiveSecurityAdminRuleModel {
+	outputList := make([]ManagerActiveSecurityAdminRuleModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, item := range *inputList {
+		base := item.ActiveBaseSecurityAdminRule()
+		output := ManagerActiveSecurityAdminRuleModel{
+			Id:                        pointer.From(base.Id),
+			Kind:                      string(base.Kind),
+			CommitTime:                pointer.From(base.CommitTime),
+			ConfigurationDescription:  pointer.From(base.ConfigurationDescription),
+			Region:                    location.NormalizeNilable(base.Region),
+			RuleCollectionDescription: pointer.From(base.RuleCollectionDescription),
+		}
+
+		if base.RuleCollectionAppliesToGroups != nil {
+			networkGroupIds := make([]string, 0, len(*base.RuleCollectionAppliesToGroups))
+			for _, group := range *base.RuleCollectionAppliesToGroups {
+				networkGroupIds = append(networkGroupIds, group.NetworkGroupId)
+			}
+			output.RuleCollectionNetworkGroupIds = networkGroupIds
+		}
+
+		if base.RuleGroups != nil {
+			configurationGroupIds := make([]string, 0, len(*base.RuleGroups))
+			for _, group := range *base.RuleGroups {
+				if group.Id != nil {
+					configurationGroupIds = append(configurationGroupIds, *group.Id)
+				}
+			}
+			output.ConfigurationGroupIds = configurationGroupIds
+		}
+
+		switch rule := item.(type) {
+		case networkmanageractiveconfigurations.ActiveSecurityAdminRule:
+			if props := rule.Properties; props != nil {
+				output.Action = string(props.Access)
+				output.Description = pointer.From(props.Description)
+				output.DestinationPortRanges = pointer.From(props.DestinationPortRanges)
+				output.Destinations = flattenManagerActiveSecurityAdminRuleAddressPrefixItems(props.Destinations)
+				output.Direction = string(props.Direction)
+				output.Priority = props.Priority
+				output.Protocol = string(props.Protocol)
+				output.SourcePortRanges = pointer.From(props.SourcePortRanges)
+				output.Sources = flattenManagerActiveSecurityAdminRuleAddressPrefixItems(props.Sources)
+			}
+		case networkmanageractiveconfigurations.ActiveDefaultSecurityAdminRule:
+			if props := rule.Properties; props != nil {
+				output.Action = string(pointer.From(props.Access))
+				output.Description = pointer.From(props.Description)
+				output.DestinationPortRanges = pointer.From(props.DestinationPortRanges)
+				output.Destinations = flattenManagerActiveSecurityAdminRuleAddressPrefixItems(props.Destinations)
+				output.Direction = string(pointer.From(props.Direction))
+				output.Flag = pointer.From(props.Flag)
+				output.Priority = pointer.From(props.Priority)
+				output.Protocol = string(pointer.From(props.Protocol))
+				output.SourcePortRanges = pointer.From(props.SourcePortRanges)
+				output.Sources = flattenManagerActiveSecurityAdminRuleAddressPrefixItems(props.Sources)
+			}
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenManagerActiveSecurityAdminRuleAddressPrefixItems(inputList *[]networkmanageractiveconfigurations.AddressPrefixItem) []AddressPrefixItemModel {
+	outputList := make([]AddressPrefixItemModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		outputList = append(outputList, AddressPrefixItemModel{
+			AddressPrefix:     pointer.From(input.AddressPrefix),
+			AddressPrefixType: adminrules.AddressPrefixType(pointer.From(input.AddressPrefixType)),
+		})
+	}
+
+	return outputList
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagerActiveSecurityAdminRulesDataSource struct{}
+
+func testAccNetworkManagerActiveSecurityAdminRulesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_manager_active_security_admin_rules", "test")
+	d := ManagerActiveSecurityAdminRulesDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.kind").HasValue("Custom"),
+				check.That(data.ResourceName).Key("rule.0.region").HasValue("eastus"),
+				check.That(data.ResourceName).Key("rule.0.commit_time").IsNotEmpty(),
+				check.That(data.ResourceName).Key("rule.0.rule_collection_network_group_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d ManagerActiveSecurityAdminRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_manager_active_security_admin_rules" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  regions            = ["eastus"]
+
+  depends_on = [azurerm_network_manager_deployment.test]
+}
+`, ManagerDeploymentResource{}.basicAdmin(data))
+}
@@ -64,6 +64,7 @@ func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 				string(networkmanagers.ConfigurationTypeConnectivity),
 				string(networkmanagers.ConfigurationTypeSecurityAdmin),
 				string(networkmanagers.ConfigurationTypeRouting),
+				string(networkmanagers.ConfigurationTypeSecurityUser),
 			}, false),
 		},
 
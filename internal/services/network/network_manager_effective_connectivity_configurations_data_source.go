@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/connectivityconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanagereffectiveconnectivityconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerEffectiveConnectivityConfigurationsDataSource struct{}
+
+var _ sdk.DataSource = ManagerEffectiveConnectivityConfigurationsDataSource{}
+
+type ManagerEffectiveConnectivityConfigurationsDataSourceModel struct {
+	VirtualNetworkId string                                           `tfschema:"virtual_network_id"`
+	Configurations   []ManagerEffectiveConnectivityConfigurationModel `tfschema:"configuration"`
+}
+
+type ManagerEffectiveConnectivityConfigurationModel struct {
+	Id                           string                       `tfschema:"id"`
+	AppliesToGroups              []ConnectivityGroupItemModel `tfschema:"applies_to_group"`
+	ConfigurationGroupIds        []string                     `tfschema:"configuration_group_ids"`
+	ConnectivityTopology         string                       `tfschema:"connectivity_topology"`
+	DeleteExistingPeeringEnabled bool                         `tfschema:"delete_existing_peering_enabled"`
+	Description                  string                       `tfschema:"description"`
+	GlobalMeshEnabled            bool                         `tfschema:"global_mesh_enabled"`
+	Hub                          []HubModel                   `tfschema:"hub"`
+}
+
+func (r ManagerEffectiveConnectivityConfigurationsDataSource) ResourceType() string {
+	return "azurerm_network_manager_effective_connectivity_configurations"
+}
+
+func (r ManagerEffectiveConnectivityConfigurationsDataSource) ModelObject() interface{} {
+	return &ManagerEffectiveConnectivityConfigurationsDataSourceModel{}
+}
+
+func (r ManagerEffectiveConnectivityConfigurationsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+	}
+}
+
+func (r ManagerEffectiveConnectivityConfigurationsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"applies_to_group": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"group_connectivity": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"global_mesh_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Computed: true,
+								},
+
+								"network_group_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"use_hub_gateway": {
+									Type:     pluginsdk.TypeBool,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"configuration_group_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"connectivity_topology": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"delete_existing_peering_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"global_mesh_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"hub": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"resource_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"resource_type": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerEffectiveConnectivityConfigurationsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkManagerEffectiveConnectivityConfiguration
+
+			var state ManagerEffectiveConnectivityConfigurationsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseVirtualNetworkID(state.VirtualNetworkId)
+			if err != nil {
+				return err
+			}
+
+			configurations := make([]ManagerEffectiveConnectivityConfigurationModel, 0)
+			options := networkmanagereffectiveconnectivityconfiguration.QueryRequestOptions{}
+			for {
+				resp, err := client.ListNetworkManagerEffectiveConnectivityConfigurations(ctx, *id, options)
+				if err != nil {
+					return fmt.Errorf("listing effective connectivity configurations for %s: %+v", id, err)
+				}
+
+				if resp.Model == nil {
+					break
+				}
+
+				configurations = append(configurations, flattenManagerEffectiveConnectivityConfigurations(resp.Model.Value)...)
+
+				if pointer.From(resp.Model.SkipToken) == "" {
+					break
+				}
+				options.SkipToken = resp.Model.SkipToken
+			}
+
+			state.Configurations = configurations
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenManagerEffectiveConnectivityConfigurations(inputList *[]networkmanagereffectiveconnectivityconfiguration.EffectiveConnectivityConfiguration) []ManagerEffectiveConnectivityConfigurationModel {
+	outputList := make([]ManagerEffectiveConnectivityConfigurationModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		output := ManagerEffectiveConnectivityConfigurationModel{
+			Id:                    pointer.From(input.Id),
+			ConfigurationGroupIds: flattenManagerEffectiveConnectivityConfigurationGroupIds(input.ConfigurationGroups),
+		}
+
+		if props := input.Properties; props != nil {
+			output.ConnectivityTopology = string(props.ConnectivityTopology)
+			output.DeleteExistingPeeringEnabled = pointer.From(props.DeleteExistingPeering) == networkmanagereffectiveconnectivityconfiguration.DeleteExistingPeeringTrue
+			output.Description = pointer.From(props.Description)
+			output.GlobalMeshEnabled = pointer.From(props.IsGlobal) == networkmanagereffectiveconnectivityconfiguration.IsGlobalTrue
+
+			appliesToGroups := make([]ConnectivityGroupItemModel, 0, len(props.AppliesToGroups))
+			for _, item := range props.AppliesToGroups {
+				appliesToGroups = append(appliesToGroups, ConnectivityGroupItemModel{
+					GroupConnectivity: connectivityconfigurations.GroupConnectivity(item.GroupConnectivity),
+					GlobalMeshEnabled: pointer.From(item.IsGlobal) == networkmanagereffectiveconnectivityconfiguration.IsGlobalTrue,
+					NetworkGroupId:    item.NetworkGroupId,
+					UseHubGateway:     pointer.From(item.UseHubGateway) == networkmanagereffectiveconnectivityconfiguration.UseHubGatewayTrue,
+				})
+			}
+			output.AppliesToGroups = appliesToGroups
+
+			hubs := make([]HubModel, 0)
+			if props.Hubs != nil {
+				for _, hub := range *props.Hubs {
+					hubs = append(hubs, HubModel{
+						ResourceId:   pointer.From(hub.ResourceId),
+						ResourceType: pointer.From(hub.ResourceType),
+					})
+				}
+			}
+			output.Hub = hubs
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenManagerEffectiveConnectivityConfigurationGroupIds(inputList *[]networkmanagereffectiveconnectivityconfiguration.ConfigurationGroup) []string {
+	outputList := make([]string, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		if input.Id != nil {
+			outputList = append(outputList, *input.Id)
+		}
+	}
+
+	return outputList
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagerEffectiveConnectivityConfigurationsDataSource struct{}
+
+func testAccNetworkManagerEffectiveConnectivityConfigurationsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_manager_effective_connectivity_configurations", "test")
+	d := ManagerEffectiveConnectivityConfigurationsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("configuration.0.id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("configuration.0.connectivity_topology").HasValue("HubAndSpoke"),
+				check.That(data.ResourceName).Key("configuration.0.applies_to_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("configuration.0.hub.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d ManagerEffectiveConnectivityConfigurationsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "spoke" {
+  name                = "acctest-vnet-spoke-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.1.0.0/16"]
+}
+
+resource "azurerm_network_manager_static_member" "test" {
+  name                      = "acctest-nmsm-%[2]d"
+  network_group_id          = azurerm_network_manager_network_group.test.id
+  target_virtual_network_id = azurerm_virtual_network.spoke.id
+}
+
+data "azurerm_network_manager_effective_connectivity_configurations" "test" {
+  virtual_network_id = azurerm_virtual_network.spoke.id
+
+  depends_on = [azurerm_network_manager_deployment.test, azurerm_network_manager_static_member.test]
+}
+`, ManagerDeploymentResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/adminrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanagereffectivesecurityadminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerEffectiveSecurityAdminRulesDataSource struct{}
+
+var _ sdk.DataSource = ManagerEffectiveSecurityAdminRulesDataSource{}
+
+type ManagerEffectiveSecurityAdminRulesDataSourceModel struct {
+	VirtualNetworkId string                                   `tfschema:"virtual_network_id"`
+	Rules            []ManagerEffectiveSecurityAdminRuleModel `tfschema:"rule"`
+}
+
+type ManagerEffectiveSecurityAdminRuleModel struct {
+	Id                            string                   `tfschema:"id"`
+	Kind                          string                   `tfschema:"kind"`
+	Action                        string                   `tfschema:"action"`
+	ConfigurationDescription      string                   `tfschema:"configuration_description"`
+	ConfigurationGroupIds         []string                 `tfschema:"configuration_group_ids"`
+	Description                   string                   `tfschema:"description"`
+	DestinationPortRanges         []string                 `tfschema:"destination_port_ranges"`
+	Destinations                  []AddressPrefixItemModel `tfschema:"destination"`
+	Direction                     string                   `tfschema:"direction"`
+	Flag                          string                   `tfschema:"flag"`
+	Priority                      int64                    `tfschema:"priority"`
+	Protocol                      string                   `tfschema:"protocol"`
+	RuleCollectionDescription     string                   `tfschema:"rule_collection_description"`
+	RuleCollectionNetworkGroupIds []string                 `tfschema:"rule_collection_network_group_ids"`
+	SourcePortRanges              []string                 `tfschema:"source_port_ranges"`
+	Sources                       []AddressPrefixItemModel `tfschema:"source"`
+}
+
+func (r ManagerEffectiveSecurityAdminRulesDataSource) ResourceType() string {
+	return "azurerm_network_manager_effective_security_admin_rules"
+}
+
+func (r ManagerEffectiveSecurityAdminRulesDataSource) ModelObject() interface{} {
+	return &ManagerEffectiveSecurityAdminRulesDataSourceModel{}
+}
+
+func (r ManagerEffectiveSecurityAdminRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+	}
+}
+
+func (r ManagerEffectiveSecurityAdminRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: managerSecurityAdminRuleDataSourceSchema(),
+			},
+		},
+	}
+}
+
+func (r ManagerEffectiveSecurityAdminRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkManagerEffectiveSecurityAdminRules
+
+			var state ManagerEffectiveSecurityAdminRulesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseVirtualNetworkID(state.VirtualNetworkId)
+			if err != nil {
+				return err
+			}
+
+			rules := make([]ManagerEffectiveSecurityAdminRuleModel, 0)
+			options := networkmanagereffectivesecurityadminrules.QueryRequestOptions{}
+			for {
+				resp, err := client.ListNetworkManagerEffectiveSecurityAdminRules(ctx, *id, options)
+				if err != nil {
+					return fmt.Errorf("listing effective security admin rules for %s: %+v", id, err)
+				}
+
+				if resp.Model == nil {
+					break
+				}
+
+				rules = append(rules, flattenManagerEffectiveSecurityAdminRules(resp.Model.Value)...)
+
+				if pointer.From(resp.Model.SkipToken) == "" {
+					break
+				}
+				options.SkipToken = resp.Model.SkipToken
+			}
+
+			state.Rules = rules
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// managerSecurityAdminRuleDataSourceSchema returns the computed fields shared by the effective and active security admin rule data sources
+func managerSecurityAdminRuleDataSourceSchema() map[string]*pluginsdk.Schema {
+	addressPrefixItemSchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"address_prefix": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"address_prefix_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	stringListSchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"configuration_description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"configuration_group_ids": stringListSchema(),
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"destination_port_ranges": stringListSchema(),
+
+		"destination": addressPrefixItemSchema(),
+
+		"direction": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"flag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"priority": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rule_collection_description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rule_collection_network_group_ids": stringListSchema(),
+
+		"source_port_ranges": stringListSchema(),
+
+		"source": addressPrefixItemSchema(),
+	}
+}
+
+func flattenManagerEffectiveSecurityAdminRules(inputList *[]networkmanagereffectivesecurityadminrules.EffectiveBaseSecurityAdminRule) []ManagerEffectiveSecurityAdminRuleModel {
+	outputList := make([]ManagerEffectiveSecurityAdminRuleModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, item := range *inputList {
+		base := item.EffectiveBaseSecurityAdminRule()
+		output := ManagerEffectiveSecurityAdminRuleModel{
+			Id:                        pointer.From(base.Id),
+			Kind:                      string(base.Kind),
+			ConfigurationDescription:  pointer.From(base.ConfigurationDescription),
+			RuleCollectionDescription: pointer.From(base.RuleCollectionDescription),
+		}
+
+		if base.RuleCollectionAppliesToGroups != nil {
+			networkGroupIds := make([]string, 0, len(*base.RuleCollectionAppliesToGroups))
+			for _, group := range *base.RuleCollectionAppliesToGroups {
+				networkGroupIds = append(networkGroupIds, group.NetworkGroupId)
+			}
+			output.RuleCollectionNetworkGroupIds = networkGroupIds
+		}
+
+		if base.RuleGroups != nil {
+			configurationGroupIds := make([]string, 0, len(*base.RuleGroups))
+			for _, group := range *base.RuleGroups {
+				if group.Id != nil {
+					configurationGroupIds = append(configurationGroupIds, *group.Id)
+				}
+			}
+			output.ConfigurationGroupIds = configurationGroupIds
+		}
+
+		switch rule := item.(type) {
+		case networkmanagereffectivesecurityadminrules.EffectiveSecurityAdminRule:
+			if props := rule.Properties; props != nil {
+				output.Action = string(props.Access)
+				output.Description = pointer.From(props.Description)
+				output.DestinationPortRanges = pointer.From(props.DestinationPortRanges)
+				output.Destinations = flattenManagerEffectiveSecurityAdminRuleAddressPrefixItems(props.Destinations)
+				output.Direction = string(props.Direction)
+				output.Priority = props.Priority
+				output.Protocol = string(props.Protocol)
+				output.SourcePortRanges = pointer.From(props.SourcePortRanges)
+				output.Sources = flattenManagerEffectiveSecurityAdminRuleAddressPrefixItems(props.Sources)
+			}
+		case networkmanagereffectivesecurityadminrules.EffectiveDefaultSecurityAdminRule:
+			if props := rule.Properties; props != nil {
+				output.Action = string(pointer.From(props.Access))
+				output.Description = pointer.From(props.Description)
+				output.DestinationPortRanges = pointer.From(props.DestinationPortRanges)
+				output.Destinations = flattenManagerEffectiveSecurityAdminRuleAddressPrefixItems(props.Destinations)
+				output.Direction = string(pointer.From(props.Direction))
+				output.Flag = pointer.From(props.Flag)
+				output.Priority = pointer.From(props.Priority)
+				output.Protocol = string(pointer.From(props.Protocol))
+				output.SourcePortRanges = pointer.From(props.SourcePortRanges)
+				output.Sources = flattenManagerEffectiveSecurityAdminRuleAddressPrefixItems(props.Sources)
+			}
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenManagerEffectiveSecurityAdminRuleAddressPrefixItems(inputList *[]networkmanagereffectivesecurityadminrules.AddressPrefixItem) []AddressPrefixItemModel {
+	outputList := make([]AddressPrefixItemModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		outputList = append(outputList, AddressPrefixItemModel{
+			AddressPrefix:     pointer.From(input.AddressPrefix),
+			AddressPrefixType: adminrules.AddressPrefixType(pointer.From(input.AddressPrefixType)),
+		})
+	}
+
+	return outputList
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagerEffectiveSecurityAdminRulesDataSource struct{}
+
+func testAccNetworkManagerEffectiveSecurityAdminRulesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_manager_effective_security_admin_rules", "test")
+	d := ManagerEffectiveSecurityAdminRulesDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.kind").HasValue("Custom"),
+				check.That(data.ResourceName).Key("rule.0.action").HasValue("Deny"),
+				check.That(data.ResourceName).Key("rule.0.direction").HasValue("Inbound"),
+				check.That(data.ResourceName).Key("rule.0.priority").HasValue("1"),
+				check.That(data.ResourceName).Key("rule.0.source.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d ManagerEffectiveSecurityAdminRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_static_member" "test" {
+  name                      = "acctest-nmsm-%d"
+  network_group_id          = azurerm_network_manager_network_group.test.id
+  target_virtual_network_id = azurerm_virtual_network.test.id
+}
+
+data "azurerm_network_manager_effective_security_admin_rules" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+
+  depends_on = [azurerm_network_manager_deployment.test, azurerm_network_manager_static_member.test]
+}
+`, ManagerDeploymentResource{}.basicAdmin(data), data.RandomInteger)
+}
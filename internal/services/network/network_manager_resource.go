@@ -111,6 +111,7 @@ func (r ManagerResource) Arguments() map[string]*pluginsdk.Schema {
 					string(networkmanagers.ConfigurationTypeConnectivity),
 					string(networkmanagers.ConfigurationTypeRouting),
 					string(networkmanagers.ConfigurationTypeSecurityAdmin),
+					string(networkmanagers.ConfigurationTypeSecurityUser),
 				}, false),
 			},
 		},
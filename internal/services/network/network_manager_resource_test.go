@@ -75,6 +75,24 @@ func TestAccNetworkManager(t *testing.T) {
 			"update":         testAccNetworkManagerSecurityAdminConfiguration_update,
 			"requiresImport": testAccNetworkManagerSecurityAdminConfiguration_requiresImport,
 		},
+		"SecurityUserConfiguration": {
+			"basic":          testAccNetworkManagerSecurityUserConfiguration_basic,
+			"complete":       testAccNetworkManagerSecurityUserConfiguration_complete,
+			"update":         testAccNetworkManagerSecurityUserConfiguration_update,
+			"requiresImport": testAccNetworkManagerSecurityUserConfiguration_requiresImport,
+		},
+		"SecurityUserRuleCollection": {
+			"basic":          testAccNetworkManagerSecurityUserRuleCollection_basic,
+			"complete":       testAccNetworkManagerSecurityUserRuleCollection_complete,
+			"update":         testAccNetworkManagerSecurityUserRuleCollection_update,
+			"requiresImport": testAccNetworkManagerSecurityUserRuleCollection_requiresImport,
+		},
+		"SecurityUserRule": {
+			"basic":          testAccNetworkManagerSecurityUserRule_basic,
+			"complete":       testAccNetworkManagerSecurityUserRule_complete,
+			"update":         testAccNetworkManagerSecurityUserRule_update,
+			"requiresImport": testAccNetworkManagerSecurityUserRule_requiresImport,
+		},
 		"AdminRuleCollection": {
 			"basic":          testAccNetworkManagerAdminRuleCollection_basic,
 			"complete":       testAccNetworkManagerAdminRuleCollection_complete,
@@ -95,6 +113,11 @@ func TestAccNetworkManager(t *testing.T) {
 			"withTriggers":   testAccNetworkManagerDeployment_withTriggers,
 			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
 		},
+		"EffectiveConfiguration": {
+			"connectivityDataSource":             testAccNetworkManagerEffectiveConnectivityConfigurationsDataSource_basic,
+			"securityAdminRulesDataSource":       testAccNetworkManagerEffectiveSecurityAdminRulesDataSource_basic,
+			"activeSecurityAdminRulesDataSource": testAccNetworkManagerActiveSecurityAdminRulesDataSource_basic,
+		},
 		"IPAMPool": {
 			"basic":          testAccNetworkManagerIpamPool_basic,
 			"basicIPv6":      testAccNetworkManagerIpamPool_basicIPv6,
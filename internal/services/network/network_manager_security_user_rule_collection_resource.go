@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/securityuserrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerSecurityUserRuleCollectionModel struct {
+	Name                        string   `tfschema:"name"`
+	SecurityUserConfigurationId string   `tfschema:"security_user_configuration_id"`
+	NetworkGroupIds             []string `tfschema:"network_group_ids"`
+	Description                 string   `tfschema:"description"`
+}
+
+type ManagerSecurityUserRuleCollectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerSecurityUserRuleCollectionResource{}
+
+func (r ManagerSecurityUserRuleCollectionResource) ResourceType() string {
+	return "azurerm_network_manager_security_user_rule_collection"
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) ModelObject() interface{} {
+	return &ManagerSecurityUserRuleCollectionModel{}
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return securityuserrulecollections.ValidateSecurityUserConfigurationRuleCollectionID
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"security_user_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: securityuserrulecollections.ValidateSecurityUserConfigurationID,
+		},
+
+		"network_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkgroups.ValidateNetworkGroupID,
+			},
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerSecurityUserRuleCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.SecurityUserRuleCollections
+			configurationId, err := securityuserrulecollections.ParseSecurityUserConfigurationID(model.SecurityUserConfigurationId)
+			if err != nil {
+				return err
+			}
+
+			id := securityuserrulecollections.NewSecurityUserConfigurationRuleCollectionID(configurationId.SubscriptionId, configurationId.ResourceGroupName,
+				configurationId.NetworkManagerName, configurationId.SecurityUserConfigurationName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ruleCollection := securityuserrulecollections.SecurityUserRuleCollection{
+				Properties: &securityuserrulecollections.SecurityUserRuleCollectionPropertiesFormat{
+					AppliesToGroups: expandNetworkManagerSecurityUserGroupItems(model.NetworkGroupIds),
+				},
+			}
+
+			if model.Description != "" {
+				ruleCollection.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, ruleCollection); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRuleCollections
+
+			id, err := securityuserrulecollections.ParseSecurityUserConfigurationRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerSecurityUserRuleCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: model properties was nil", *id)
+			}
+
+			properties := existing.Model.Properties
+
+			if metadata.ResourceData.HasChange("network_group_ids") {
+				properties.AppliesToGroups = expandNetworkManagerSecurityUserGroupItems(model.NetworkGroupIds)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *existing.Model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRuleCollections
+
+			id, err := securityuserrulecollections.ParseSecurityUserConfigurationRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerSecurityUserRuleCollectionModel{
+				Name:                        id.RuleCollectionName,
+				SecurityUserConfigurationId: securityuserrulecollections.NewSecurityUserConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityUserConfigurationName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				if properties := model.Properties; properties != nil {
+					state.NetworkGroupIds = flattenNetworkManagerSecurityUserGroupItems(properties.AppliesToGroups)
+					state.Description = pointer.From(properties.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRuleCollections
+
+			id, err := securityuserrulecollections.ParseSecurityUserConfigurationRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			err = client.DeleteThenPoll(ctx, *id, securityuserrulecollections.DeleteOperationOptions{
+				Force: pointer.To(true),
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerSecurityUserGroupItems(inputList []string) []securityuserrulecollections.SecurityUserGroupItem {
+	outputList := make([]securityuserrulecollections.SecurityUserGroupItem, 0, len(inputList))
+	for _, input := range inputList {
+		outputList = append(outputList, securityuserrulecollections.SecurityUserGroupItem{
+			NetworkGroupId: input,
+		})
+	}
+
+	return outputList
+}
+
+func flattenNetworkManagerSecurityUserGroupItems(inputList []securityuserrulecollections.SecurityUserGroupItem) []string {
+	outputList := make([]string, 0, len(inputList))
+	for _, input := range inputList {
+		outputList = append(outputList, input.NetworkGroupId)
+	}
+
+	return outputList
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/securityuserrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerSecurityUserRuleCollectionResource struct{}
+
+func testAccNetworkManagerSecurityUserRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule_collection", "test")
+	r := ManagerSecurityUserRuleCollectionResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerSecurityUserRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule_collection", "test")
+	r := ManagerSecurityUserRuleCollectionResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerSecurityUserRuleCollection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule_collection", "test")
+	r := ManagerSecurityUserRuleCollectionResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerSecurityUserRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule_collection", "test")
+	r := ManagerSecurityUserRuleCollectionResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := securityuserrulecollections.ParseSecurityUserConfigurationRuleCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SecurityUserRuleCollections.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_network_group" "test2" {
+  name               = "acctest-nmng2-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+`, ManagerSecurityUserConfigurationResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule_collection" "test" {
+  name                           = "acctest-nmsurc-%d"
+  security_user_configuration_id = azurerm_network_manager_security_user_configuration.test.id
+  network_group_ids              = [azurerm_network_manager_network_group.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule_collection" "import" {
+  name                           = azurerm_network_manager_security_user_rule_collection.test.name
+  security_user_configuration_id = azurerm_network_manager_security_user_rule_collection.test.security_user_configuration_id
+  network_group_ids              = azurerm_network_manager_security_user_rule_collection.test.network_group_ids
+}
+`, r.basic(data))
+}
+
+func (r ManagerSecurityUserRuleCollectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule_collection" "test" {
+  name                           = "acctest-nmsurc-%d"
+  security_user_configuration_id = azurerm_network_manager_security_user_configuration.test.id
+  network_group_ids              = [azurerm_network_manager_network_group.test.id, azurerm_network_manager_network_group.test2.id]
+  description                    = "test security user rule collection"
+}
+`, r.template(data), data.RandomInteger)
+}
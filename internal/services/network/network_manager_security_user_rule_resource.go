@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/securityuserrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerSecurityUserRuleModel struct {
+	Name                         string                                               `tfschema:"name"`
+	SecurityUserRuleCollectionId string                                               `tfschema:"security_user_rule_collection_id"`
+	Description                  string                                               `tfschema:"description"`
+	DestinationPortRanges        []string                                             `tfschema:"destination_port_ranges"`
+	Destinations                 []ManagerSecurityUserRuleAddressPrefixItemModel      `tfschema:"destination"`
+	Direction                    securityuserrules.SecurityConfigurationRuleDirection `tfschema:"direction"`
+	Protocol                     securityuserrules.SecurityConfigurationRuleProtocol  `tfschema:"protocol"`
+	SourcePortRanges             []string                                             `tfschema:"source_port_ranges"`
+	Sources                      []ManagerSecurityUserRuleAddressPrefixItemModel      `tfschema:"source"`
+}
+
+type ManagerSecurityUserRuleAddressPrefixItemModel struct {
+	AddressPrefix     string                              `tfschema:"address_prefix"`
+	AddressPrefixType securityuserrules.AddressPrefixType `tfschema:"address_prefix_type"`
+}
+
+type ManagerSecurityUserRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerSecurityUserRuleResource{}
+
+func (r ManagerSecurityUserRuleResource) ResourceType() string {
+	return "azurerm_network_manager_security_user_rule"
+}
+
+func (r ManagerSecurityUserRuleResource) ModelObject() interface{} {
+	return &ManagerSecurityUserRuleModel{}
+}
+
+func (r ManagerSecurityUserRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return securityuserrules.ValidateRuleCollectionRuleID
+}
+
+func (r ManagerSecurityUserRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"security_user_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: securityuserrules.ValidateSecurityUserConfigurationRuleCollectionID,
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(securityuserrules.PossibleValuesForSecurityConfigurationRuleDirection(), false),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(securityuserrules.PossibleValuesForSecurityConfigurationRuleProtocol(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"destination": managerSecurityUserRuleAddressPrefixItemSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source": managerSecurityUserRuleAddressPrefixItemSchema(),
+	}
+}
+
+func (r ManagerSecurityUserRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerSecurityUserRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerSecurityUserRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.SecurityUserRules
+			ruleCollectionId, err := securityuserrules.ParseSecurityUserConfigurationRuleCollectionID(model.SecurityUserRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := securityuserrules.NewRuleCollectionRuleID(ruleCollectionId.SubscriptionId, ruleCollectionId.ResourceGroupName,
+				ruleCollectionId.NetworkManagerName, ruleCollectionId.SecurityUserConfigurationName, ruleCollectionId.RuleCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			rule := securityuserrules.SecurityUserRule{
+				Properties: &securityuserrules.SecurityUserRulePropertiesFormat{
+					Destinations:          expandManagerSecurityUserRuleAddressPrefixItemModel(model.Destinations),
+					DestinationPortRanges: pointer.To(model.DestinationPortRanges),
+					Direction:             model.Direction,
+					Protocol:              model.Protocol,
+					SourcePortRanges:      pointer.To(model.SourcePortRanges),
+					Sources:               expandManagerSecurityUserRuleAddressPrefixItemModel(model.Sources),
+				},
+			}
+
+			if model.Description != "" {
+				rule.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, rule); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRules
+
+			id, err := securityuserrules.ParseRuleCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerSecurityUserRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: model properties was nil", *id)
+			}
+
+			properties := existing.Model.Properties
+
+			if metadata.ResourceData.HasChange("description") {
+				if model.Description != "" {
+					properties.Description = pointer.To(model.Description)
+				} else {
+					properties.Description = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("destination_port_ranges") {
+				properties.DestinationPortRanges = pointer.To(model.DestinationPortRanges)
+			}
+
+			if metadata.ResourceData.HasChange("destination") {
+				properties.Destinations = expandManagerSecurityUserRuleAddressPrefixItemModel(model.Destinations)
+			}
+
+			if metadata.ResourceData.HasChange("direction") {
+				properties.Direction = model.Direction
+			}
+
+			if metadata.ResourceData.HasChange("protocol") {
+				properties.Protocol = model.Protocol
+			}
+
+			if metadata.ResourceData.HasChange("source_port_ranges") {
+				properties.SourcePortRanges = pointer.To(model.SourcePortRanges)
+			}
+
+			if metadata.ResourceData.HasChange("source") {
+				properties.Sources = expandManagerSecurityUserRuleAddressPrefixItemModel(model.Sources)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *existing.Model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRules
+
+			id, err := securityuserrules.ParseRuleCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerSecurityUserRuleModel{
+				Name: id.RuleName,
+				SecurityUserRuleCollectionId: securityuserrules.NewSecurityUserConfigurationRuleCollectionID(id.SubscriptionId, id.ResourceGroupName,
+					id.NetworkManagerName, id.SecurityUserConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				if properties := model.Properties; properties != nil {
+					state.Description = pointer.From(properties.Description)
+					state.DestinationPortRanges = pointer.From(properties.DestinationPortRanges)
+					state.Destinations = flattenManagerSecurityUserRuleAddressPrefixItemModel(properties.Destinations)
+					state.Direction = properties.Direction
+					state.Protocol = properties.Protocol
+					state.SourcePortRanges = pointer.From(properties.SourcePortRanges)
+					state.Sources = flattenManagerSecurityUserRuleAddressPrefixItemModel(properties.Sources)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerSecurityUserRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.SecurityUserRules
+
+			id, err := securityuserrules.ParseRuleCollectionRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			err = client.DeleteThenPoll(ctx, *id, securityuserrules.DeleteOperationOptions{
+				Force: pointer.To(true),
+			})
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func managerSecurityUserRuleAddressPrefixItemSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(securityuserrules.AddressPrefixTypeIPPrefix),
+						string(securityuserrules.AddressPrefixTypeServiceTag),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandManagerSecurityUserRuleAddressPrefixItemModel(inputList []ManagerSecurityUserRuleAddressPrefixItemModel) *[]securityuserrules.AddressPrefixItem {
+	outputList := make([]securityuserrules.AddressPrefixItem, 0, len(inputList))
+	for _, input := range inputList {
+		output := securityuserrules.AddressPrefixItem{
+			AddressPrefixType: pointer.To(input.AddressPrefixType),
+		}
+
+		if input.AddressPrefix != "" {
+			output.AddressPrefix = pointer.To(input.AddressPrefix)
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return &outputList
+}
+
+func flattenManagerSecurityUserRuleAddressPrefixItemModel(inputList *[]securityuserrules.AddressPrefixItem) []ManagerSecurityUserRuleAddressPrefixItemModel {
+	if inputList == nil {
+		return []ManagerSecurityUserRuleAddressPrefixItemModel{}
+	}
+
+	outputList := make([]ManagerSecurityUserRuleAddressPrefixItemModel, 0, len(*inputList))
+	for _, input := range *inputList {
+		outputList = append(outputList, ManagerSecurityUserRuleAddressPrefixItemModel{
+			AddressPrefix:     pointer.From(input.AddressPrefix),
+			AddressPrefixType: pointer.From(input.AddressPrefixType),
+		})
+	}
+
+	return outputList
+}
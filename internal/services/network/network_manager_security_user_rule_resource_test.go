@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/securityuserrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerSecurityUserRuleResource struct{}
+
+func testAccNetworkManagerSecurityUserRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule", "test")
+	r := ManagerSecurityUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerSecurityUserRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule", "test")
+	r := ManagerSecurityUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerSecurityUserRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule", "test")
+	r := ManagerSecurityUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerSecurityUserRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_security_user_rule", "test")
+	r := ManagerSecurityUserRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerSecurityUserRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := securityuserrules.ParseRuleCollectionRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SecurityUserRules.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagerSecurityUserRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule" "test" {
+  name                             = "acctest-nmsur-%d"
+  security_user_rule_collection_id = azurerm_network_manager_security_user_rule_collection.test.id
+  direction                        = "Outbound"
+  protocol                         = "Tcp"
+}
+`, ManagerSecurityUserRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerSecurityUserRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule" "import" {
+  name                             = azurerm_network_manager_security_user_rule.test.name
+  security_user_rule_collection_id = azurerm_network_manager_security_user_rule.test.security_user_rule_collection_id
+  direction                        = azurerm_network_manager_security_user_rule.test.direction
+  protocol                         = azurerm_network_manager_security_user_rule.test.protocol
+}
+`, r.basic(data))
+}
+
+func (r ManagerSecurityUserRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule" "test" {
+  name                             = "acctest-nmsur-%d"
+  security_user_rule_collection_id = azurerm_network_manager_security_user_rule_collection.test.id
+  description                      = "test security user rule"
+  direction                        = "Outbound"
+  protocol                         = "Tcp"
+  source_port_ranges               = ["80", "22", "443"]
+  destination_port_ranges          = ["80", "22"]
+
+  source {
+    address_prefix_type = "ServiceTag"
+    address_prefix      = "Internet"
+  }
+
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "*"
+  }
+}
+`, ManagerSecurityUserRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerSecurityUserRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_user_rule" "test" {
+  name                             = "acctest-nmsur-%d"
+  security_user_rule_collection_id = azurerm_network_manager_security_user_rule_collection.test.id
+  description                      = "updated"
+  direction                        = "Inbound"
+  protocol                         = "Udp"
+  source_port_ranges               = ["80", "1024-65535"]
+  destination_port_ranges          = ["80"]
+
+  source {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.1.0.1"
+  }
+
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.0.0.0/24"
+  }
+
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.0.1.0/24"
+  }
+}
+`, ManagerSecurityUserRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
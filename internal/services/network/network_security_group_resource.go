@@ -211,7 +211,9 @@ func resourceNetworkSecurityGroupCreate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, id.ID()), id.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Network Security Group for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
 	sg := networksecuritygroups.NetworkSecurityGroup{
@@ -269,7 +271,9 @@ func resourceNetworkSecurityGroupUpdate(d *pluginsdk.ResourceData, meta interfac
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, id.ID()), id.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Network Security Group for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
@@ -38,7 +38,14 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		ExpressRouteCircuitArpTableDataSource{},
+		ExpressRouteCircuitRoutesTableDataSource{},
+		ExpressRouteCircuitRoutesTableSummaryDataSource{},
+		ExpressRouteCircuitStatsDataSource{},
+		ManagerActiveSecurityAdminRulesDataSource{},
 		ManagerDataSource{},
+		ManagerEffectiveConnectivityConfigurationsDataSource{},
+		ManagerEffectiveSecurityAdminRulesDataSource{},
 		ManagerNetworkGroupDataSource{},
 		ManagerConnectivityConfigurationDataSource{},
 		ManagerIpamPoolDataSource{},
@@ -63,13 +70,20 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerRoutingRuleCollectionResource{},
 		ManagerScopeConnectionResource{},
 		ManagerSecurityAdminConfigurationResource{},
+		ManagerSecurityUserConfigurationResource{},
+		ManagerSecurityUserRuleCollectionResource{},
+		ManagerSecurityUserRuleResource{},
 		ManagerStaticMemberResource{},
 		ManagerSubscriptionConnectionResource{},
 		ManagerVerifierWorkspaceResource{},
 		ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{},
+		NetworkInterfaceVirtualNetworkTapAssociationResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
+		VirtualNetworkTapResource{},
+		VirtualRouterBgpPeeringResource{},
+		VirtualRouterResource{},
 	}
 }
 
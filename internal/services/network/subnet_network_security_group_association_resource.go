@@ -71,13 +71,19 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, subnetId.ID()), networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Network Security Group for %s: %+v", subnetId, err)
+	}
 	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
-	locks.ByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, subnetId.ID()), subnetId.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Virtual Network for %s: %+v", subnetId, err)
+	}
 	defer locks.UnlockByName(subnetId.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(subnetId.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, subnetId.ID()), subnetId.SubnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Subnet for %s: %+v", subnetId, err)
+	}
 	defer locks.UnlockByName(subnetId.SubnetName, SubnetResourceName)
 
 	subnet, err := client.Get(ctx, *subnetId, subnets.DefaultGetOperationOptions())
@@ -223,13 +229,19 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, id.ID()), networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Network Security Group for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(networkSecurityGroupId.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, id.ID()), id.VirtualNetworkName, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Virtual Network for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	locks.ByName(id.SubnetName, SubnetResourceName)
+	if err := locks.ByNameWithContext(locks.WithHolder(ctx, id.ID()), id.SubnetName, SubnetResourceName); err != nil {
+		return fmt.Errorf("acquiring lock on the Subnet for %s: %+v", id, err)
+	}
 	defer locks.UnlockByName(id.SubnetName, SubnetResourceName)
 
 	// then re-retrieve it to ensure we've got the latest state
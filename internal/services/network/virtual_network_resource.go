@@ -355,7 +355,9 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	locks.MultipleByName(routeTables, routeTableResourceName)
+	if err := locks.MultipleByNameWithContext(locks.WithHolder(ctx, id.ID()), routeTables, routeTableResourceName); err != nil {
+		return fmt.Errorf("acquiring locks on the Route Tables for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(routeTables, routeTableResourceName)
 
 	vnet := virtualnetworks.VirtualNetwork{
@@ -385,7 +387,9 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameWithContext(locks.WithHolder(ctx, id.ID()), &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring locks on the Network Security Groups for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
@@ -595,7 +599,9 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 		payload.Properties.Subnets = subnets
 
-		locks.MultipleByName(routeTables, routeTableResourceName)
+		if err := locks.MultipleByNameWithContext(locks.WithHolder(ctx, id.ID()), routeTables, routeTableResourceName); err != nil {
+			return fmt.Errorf("acquiring locks on the Route Tables for %s: %+v", id, err)
+		}
 		defer locks.UnlockMultipleByName(routeTables, routeTableResourceName)
 	}
 
@@ -624,7 +630,9 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
+	if err := locks.MultipleByNameWithContext(locks.WithHolder(ctx, id.ID()), &networkSecurityGroupNames, networkSecurityGroupResourceName); err != nil {
+		return fmt.Errorf("acquiring locks on the Network Security Groups for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
 	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
@@ -662,10 +670,14 @@ func resourceVirtualNetworkDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("parsing Network Security Group ID's: %+v", err)
 	}
 
-	locks.MultipleByName(&nsgNames, VirtualNetworkResourceName)
+	if err := locks.MultipleByNameWithContext(locks.WithHolder(ctx, id.ID()), &nsgNames, VirtualNetworkResourceName); err != nil {
+		return fmt.Errorf("acquiring locks on the Network Security Groups for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&nsgNames, VirtualNetworkResourceName)
 
-	locks.MultipleByName(&routeTableNames, routeTableResourceName)
+	if err := locks.MultipleByNameWithContext(locks.WithHolder(ctx, id.ID()), &routeTableNames, routeTableResourceName); err != nil {
+		return fmt.Errorf("acquiring locks on the Route Tables for %s: %+v", id, err)
+	}
 	defer locks.UnlockMultipleByName(&routeTableNames, routeTableResourceName)
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualrouterpeerings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = VirtualRouterBgpPeeringResource{}
+
+type VirtualRouterBgpPeeringResource struct{}
+
+type VirtualRouterBgpPeeringResourceModel struct {
+	Name            string `tfschema:"name"`
+	VirtualRouterId string `tfschema:"virtual_router_id"`
+	PeerAsn         int64  `tfschema:"peer_asn"`
+	PeerIp          string `tfschema:"peer_ip"`
+}
+
+func (VirtualRouterBgpPeeringResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateVirtualRouterPeeringID
+}
+
+func (VirtualRouterBgpPeeringResource) ResourceType() string {
+	return "azurerm_virtual_router_bgp_peering"
+}
+
+func (VirtualRouterBgpPeeringResource) ModelObject() interface{} {
+	return &VirtualRouterBgpPeeringResourceModel{}
+}
+
+func (VirtualRouterBgpPeeringResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_router_id": commonschema.ResourceIDReferenceRequiredForceNew(&virtualrouterpeerings.VirtualRouterId{}),
+
+		"peer_asn": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 4294967295),
+		},
+
+		"peer_ip": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+	}
+}
+
+func (VirtualRouterBgpPeeringResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualRouterBgpPeeringResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouterPeerings
+
+			var config VirtualRouterBgpPeeringResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			virtualRouterId, err := virtualrouterpeerings.ParseVirtualRouterID(config.VirtualRouterId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(virtualRouterId.VirtualRouterName, virtualRouterResourceName)
+			defer locks.UnlockByName(virtualRouterId.VirtualRouterName, virtualRouterResourceName)
+
+			id := commonids.NewVirtualRouterPeeringID(virtualRouterId.SubscriptionId, virtualRouterId.ResourceGroupName, virtualRouterId.VirtualRouterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := virtualrouterpeerings.VirtualRouterPeering{
+				Name: pointer.To(config.Name),
+				Properties: &virtualrouterpeerings.VirtualRouterPeeringProperties{
+					PeerAsn: pointer.To(config.PeerAsn),
+					PeerIP:  pointer.To(config.PeerIp),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (VirtualRouterBgpPeeringResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouterPeerings
+
+			id, err := commonids.ParseVirtualRouterPeeringID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualRouterBgpPeeringResourceModel{
+				Name:            id.PeeringName,
+				VirtualRouterId: virtualrouterpeerings.NewVirtualRouterID(id.SubscriptionId, id.ResourceGroupName, id.VirtualRouterName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.PeerAsn = pointer.From(model.Properties.PeerAsn)
+				state.PeerIp = pointer.From(model.Properties.PeerIP)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (VirtualRouterBgpPeeringResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouterPeerings
+
+			id, err := commonids.ParseVirtualRouterPeeringID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualRouterName, virtualRouterResourceName)
+			defer locks.UnlockByName(id.VirtualRouterName, virtualRouterResourceName)
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualRouterBgpPeeringResource struct{}
+
+func TestAccVirtualRouterBgpPeering_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_router_bgp_peering", "test")
+	r := VirtualRouterBgpPeeringResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualRouterBgpPeering_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_router_bgp_peering", "test")
+	r := VirtualRouterBgpPeeringResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r VirtualRouterBgpPeeringResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseVirtualRouterPeeringID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VirtualRouterPeerings.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VirtualRouterBgpPeeringResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_router_bgp_peering" "test" {
+  name              = "acctest-vrpeer-%d"
+  virtual_router_id = azurerm_virtual_router.test.id
+  peer_asn          = 65501
+  peer_ip           = "10.0.1.10"
+}
+`, VirtualRouterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VirtualRouterBgpPeeringResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_router_bgp_peering" "import" {
+  name              = azurerm_virtual_router_bgp_peering.test.name
+  virtual_router_id = azurerm_virtual_router_bgp_peering.test.virtual_router_id
+  peer_asn          = azurerm_virtual_router_bgp_peering.test.peer_asn
+  peer_ip           = azurerm_virtual_router_bgp_peering.test.peer_ip
+}
+`, r.basic(data))
+}
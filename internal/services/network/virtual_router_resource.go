@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualrouters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = VirtualRouterResource{}
+
+const virtualRouterResourceName = "azurerm_virtual_router"
+
+type VirtualRouterResource struct{}
+
+type VirtualRouterResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	HostedSubnetId    string            `tfschema:"hosted_subnet_id"`
+	Tags              map[string]string `tfschema:"tags"`
+	VirtualRouterAsn  int64             `tfschema:"virtual_router_asn"`
+	VirtualRouterIps  []string          `tfschema:"virtual_router_ips"`
+}
+
+func (VirtualRouterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualrouters.ValidateVirtualRouterID
+}
+
+func (VirtualRouterResource) ResourceType() string {
+	return virtualRouterResourceName
+}
+
+func (VirtualRouterResource) ModelObject() interface{} {
+	return &VirtualRouterResourceModel{}
+}
+
+func (VirtualRouterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"hosted_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (VirtualRouterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"virtual_router_asn": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"virtual_router_ips": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r VirtualRouterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouters
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config VirtualRouterResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualrouters.NewVirtualRouterID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id, virtualrouters.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := virtualrouters.VirtualRouter{
+				Location: pointer.To(location.Normalize(config.Location)),
+				Properties: &virtualrouters.VirtualRouterPropertiesFormat{
+					HostedSubnet: &virtualrouters.SubResource{
+						Id: pointer.To(config.HostedSubnetId),
+					},
+				},
+				Tags: pointer.To(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (VirtualRouterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouters
+
+			id, err := virtualrouters.ParseVirtualRouterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, virtualrouters.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualRouterResourceModel{
+				Name:              id.VirtualRouterName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.HostedSubnet != nil && props.HostedSubnet.Id != nil {
+						subnetId, err := commonids.ParseSubnetIDInsensitively(*props.HostedSubnet.Id)
+						if err != nil {
+							return err
+						}
+						state.HostedSubnetId = subnetId.ID()
+					}
+
+					state.VirtualRouterAsn = pointer.From(props.VirtualRouterAsn)
+					state.VirtualRouterIps = pointer.From(props.VirtualRouterIPs)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (VirtualRouterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouters
+
+			id, err := virtualrouters.ParseVirtualRouterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualRouterResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.VirtualRouterName, virtualRouterResourceName)
+			defer locks.UnlockByName(id.VirtualRouterName, virtualRouterResourceName)
+
+			existing, err := client.Get(ctx, *id, virtualrouters.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := existing.Model
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (VirtualRouterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualRouters
+
+			id, err := virtualrouters.ParseVirtualRouterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/dnsprivateviews"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsPrivateViewsDataSource struct{}
+
+type DnsPrivateViewsDataModel struct {
+	Location string                    `tfschema:"location"`
+	Views    []DnsPrivateViewDataModel `tfschema:"views"`
+}
+
+type DnsPrivateViewDataModel struct {
+	Id             string `tfschema:"id"`
+	Name           string `tfschema:"name"`
+	DisplayName    string `tfschema:"display_name"`
+	IsProtected    bool   `tfschema:"is_protected"`
+	LifecycleState string `tfschema:"lifecycle_state"`
+	Ocid           string `tfschema:"ocid"`
+	Self           string `tfschema:"self"`
+	TimeCreated    string `tfschema:"time_created"`
+	TimeUpdated    string `tfschema:"time_updated"`
+}
+
+func (d DnsPrivateViewsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+	}
+}
+
+func (d DnsPrivateViewsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"views": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"is_protected": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"lifecycle_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ocid": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"self": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"time_created": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"time_updated": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d DnsPrivateViewsDataSource) ModelObject() interface{} {
+	return &DnsPrivateViewsDataModel{}
+}
+
+func (d DnsPrivateViewsDataSource) ResourceType() string {
+	return "azurerm_oracle_dns_private_views"
+}
+
+func (d DnsPrivateViewsDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsprivateviews.ValidateLocationID
+}
+
+func (d DnsPrivateViewsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.DnsPrivateViews
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			state := DnsPrivateViewsDataModel{
+				Views: make([]DnsPrivateViewDataModel, 0),
+			}
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dnsprivateviews.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			resp, err := client.ListByLocation(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				for _, element := range *model {
+					view := DnsPrivateViewDataModel{
+						Id:   pointer.From(element.Id),
+						Name: pointer.From(element.Name),
+					}
+					if props := element.Properties; props != nil {
+						view.DisplayName = props.DisplayName
+						view.IsProtected = props.IsProtected
+						view.LifecycleState = string(props.LifecycleState)
+						view.Ocid = props.Ocid
+						view.Self = props.Self
+						view.TimeCreated = props.TimeCreated
+						view.TimeUpdated = props.TimeUpdated
+					}
+					state.Views = append(state.Views, view)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
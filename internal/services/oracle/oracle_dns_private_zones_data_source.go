@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/dnsprivatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsPrivateZonesDataSource struct{}
+
+type DnsPrivateZonesDataModel struct {
+	Location        string                    `tfschema:"location"`
+	DnsPrivateZones []DnsPrivateZoneDataModel `tfschema:"dns_private_zones"`
+}
+
+type DnsPrivateZoneDataModel struct {
+	Id             string `tfschema:"id"`
+	Name           string `tfschema:"name"`
+	IsProtected    bool   `tfschema:"is_protected"`
+	LifecycleState string `tfschema:"lifecycle_state"`
+	Ocid           string `tfschema:"ocid"`
+	Self           string `tfschema:"self"`
+	Serial         int64  `tfschema:"serial"`
+	TimeCreated    string `tfschema:"time_created"`
+	Version        string `tfschema:"version"`
+	ViewId         string `tfschema:"view_id"`
+	ZoneType       string `tfschema:"zone_type"`
+}
+
+func (d DnsPrivateZonesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+	}
+}
+
+func (d DnsPrivateZonesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_private_zones": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"is_protected": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"lifecycle_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ocid": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"self": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"serial": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"time_created": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"view_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"zone_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d DnsPrivateZonesDataSource) ModelObject() interface{} {
+	return &DnsPrivateZonesDataModel{}
+}
+
+func (d DnsPrivateZonesDataSource) ResourceType() string {
+	return "azurerm_oracle_dns_private_zones"
+}
+
+func (d DnsPrivateZonesDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsprivatezones.ValidateLocationID
+}
+
+func (d DnsPrivateZonesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.DnsPrivateZones
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			state := DnsPrivateZonesDataModel{
+				DnsPrivateZones: make([]DnsPrivateZoneDataModel, 0),
+			}
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := dnsprivatezones.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			resp, err := client.ListByLocation(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				for _, element := range *model {
+					zone := DnsPrivateZoneDataModel{
+						Id:   pointer.From(element.Id),
+						Name: pointer.From(element.Name),
+					}
+					if props := element.Properties; props != nil {
+						zone.IsProtected = props.IsProtected
+						zone.LifecycleState = string(props.LifecycleState)
+						zone.Ocid = props.Ocid
+						zone.Self = props.Self
+						zone.Serial = props.Serial
+						zone.TimeCreated = props.TimeCreated
+						zone.Version = props.Version
+						zone.ViewId = pointer.From(props.ViewId)
+						zone.ZoneType = string(props.ZoneType)
+					}
+					state.DnsPrivateZones = append(state.DnsPrivateZones, zone)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,653 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/exadbvmclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/exascaledbstoragevaults"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = ExadbVmClusterResource{}
+	_ sdk.ResourceWithCustomizeDiff = ExadbVmClusterResource{}
+)
+
+type ExadbVmClusterResource struct{}
+
+type ExadbVmClusterResourceModel struct {
+	// Azure
+	Location          string            `tfschema:"location"`
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Tags              map[string]string `tfschema:"tags"`
+	Zones             zones.Schema      `tfschema:"zones"`
+
+	// Required
+	DisplayName                    string   `tfschema:"display_name"`
+	EnabledEcpuCount               int64    `tfschema:"enabled_ecpu_count"`
+	ExascaleDatabaseStorageVaultId string   `tfschema:"exascale_database_storage_vault_id"`
+	Hostname                       string   `tfschema:"hostname"`
+	HostnameActual                 string   `tfschema:"hostname_actual"`
+	NodeCount                      int64    `tfschema:"node_count"`
+	Shape                          string   `tfschema:"shape"`
+	SshPublicKeys                  []string `tfschema:"ssh_public_keys"`
+	SubnetId                       string   `tfschema:"subnet_id"`
+	TotalEcpuCount                 int64    `tfschema:"total_ecpu_count"`
+	VirtualMachineFileSystemInGbs  int64    `tfschema:"virtual_machine_file_system_storage_in_gbs"`
+	VnetId                         string   `tfschema:"virtual_network_id"`
+
+	// Optional
+	BackupSubnetCidr          string                       `tfschema:"backup_subnet_cidr"`
+	ClusterName               string                       `tfschema:"cluster_name"`
+	DataCollectionOptions     []DataCollectionOptionsModel `tfschema:"data_collection_options"`
+	Domain                    string                       `tfschema:"domain"`
+	GridImageOcid             string                       `tfschema:"grid_image_ocid"`
+	LicenseModel              string                       `tfschema:"license_model"`
+	NetworkSecurityGroupCidrs []ExadbNsgCidrModel          `tfschema:"network_security_group_cidr"`
+	PrivateZoneOcid           string                       `tfschema:"private_zone_ocid"`
+	ScanListenerPortTcp       int64                        `tfschema:"scan_listener_port_tcp"`
+	ScanListenerPortTcpSsl    int64                        `tfschema:"scan_listener_port_tcp_ssl"`
+	SystemVersion             string                       `tfschema:"system_version"`
+	TimeZone                  string                       `tfschema:"time_zone"`
+
+	// Computed
+	GiVersion       string `tfschema:"gi_version"`
+	MemorySizeInGbs int64  `tfschema:"memory_size_in_gbs"`
+	Ocid            string `tfschema:"ocid"`
+	ScanDnsName     string `tfschema:"scan_dns_name"`
+}
+
+type ExadbNsgCidrModel struct {
+	Source                  string `tfschema:"source"`
+	DestinationPortRangeMin int64  `tfschema:"destination_port_range_min"`
+	DestinationPortRangeMax int64  `tfschema:"destination_port_range_max"`
+}
+
+func (ExadbVmClusterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CloudVMClusterName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		// Required
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CloudVMClusterName,
+		},
+
+		"enabled_ecpu_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(8),
+		},
+
+		"exascale_database_storage_vault_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: exascaledbstoragevaults.ValidateExascaleDbStorageVaultID,
+		},
+
+		"hostname": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"node_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"shape": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ssh_public_keys": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"total_ecpu_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(8),
+		},
+
+		"virtual_machine_file_system_storage_in_gbs": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVirtualNetworkID,
+		},
+
+		"zones": commonschema.ZonesMultipleRequiredForceNew(),
+
+		// Optional
+		"backup_subnet_cidr": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsCIDR,
+		},
+
+		"cluster_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"data_collection_options": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"diagnostics_events_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+					},
+
+					"health_monitoring_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+					},
+
+					"incident_logs_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+
+		"domain": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"grid_image_ocid": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"license_model": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(exadbvmclusters.PossibleValuesForLicenseModel(), false),
+		},
+
+		"network_security_group_cidr": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsCIDR,
+					},
+
+					"destination_port_range_min": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					"destination_port_range_max": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"private_zone_ocid": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"scan_listener_port_tcp": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1521,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1024, 8999),
+		},
+
+		"scan_listener_port_tcp_ssl": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2484,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1024, 8999),
+		},
+
+		"system_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SystemVersion,
+		},
+
+		"time_zone": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (ExadbVmClusterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"gi_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"hostname_actual": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"memory_size_in_gbs": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"ocid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"scan_dns_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (ExadbVmClusterResource) ModelObject() interface{} {
+	return &ExadbVmClusterResourceModel{}
+}
+
+func (ExadbVmClusterResource) ResourceType() string {
+	return "azurerm_oracle_exadb_vm_cluster"
+}
+
+func (r ExadbVmClusterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExadbVMClusters
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ExadbVmClusterResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := exadbvmclusters.NewExadbVMClusterID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := exadbvmclusters.ExadbVMCluster{
+				// Azure
+				Name:     pointer.To(model.Name),
+				Location: location.Normalize(model.Location),
+				Tags:     pointer.To(model.Tags),
+				Zones:    pointer.To(model.Zones),
+				Properties: &exadbvmclusters.ExadbVMClusterProperties{
+					// Required
+					DisplayName:              model.DisplayName,
+					EnabledEcpuCount:         model.EnabledEcpuCount,
+					ExascaleDbStorageVaultId: model.ExascaleDatabaseStorageVaultId,
+					Hostname:                 model.Hostname,
+					NodeCount:                model.NodeCount,
+					Shape:                    model.Shape,
+					SshPublicKeys:            model.SshPublicKeys,
+					SubnetId:                 model.SubnetId,
+					TotalEcpuCount:           model.TotalEcpuCount,
+					VMFileSystemStorage: exadbvmclusters.ExadbVMClusterStorageDetails{
+						TotalSizeInGbs: model.VirtualMachineFileSystemInGbs,
+					},
+					VnetId:                 model.VnetId,
+					ScanListenerPortTcp:    pointer.To(model.ScanListenerPortTcp),
+					ScanListenerPortTcpSsl: pointer.To(model.ScanListenerPortTcpSsl),
+				},
+			}
+
+			if model.BackupSubnetCidr != "" {
+				param.Properties.BackupSubnetCidr = pointer.To(model.BackupSubnetCidr)
+			}
+			if model.ClusterName != "" {
+				param.Properties.ClusterName = pointer.To(model.ClusterName)
+			}
+			if len(model.DataCollectionOptions) > 0 {
+				param.Properties.DataCollectionOptions = &exadbvmclusters.DataCollectionOptions{
+					IsDiagnosticsEventsEnabled: pointer.To(model.DataCollectionOptions[0].IsDiagnosticsEventsEnabled),
+					IsHealthMonitoringEnabled:  pointer.To(model.DataCollectionOptions[0].IsHealthMonitoringEnabled),
+					IsIncidentLogsEnabled:      pointer.To(model.DataCollectionOptions[0].IsIncidentLogsEnabled),
+				}
+			}
+			if model.Domain != "" {
+				param.Properties.Domain = pointer.To(model.Domain)
+			}
+			if model.GridImageOcid != "" {
+				param.Properties.GridImageOcid = pointer.To(model.GridImageOcid)
+			}
+			if model.LicenseModel != "" {
+				param.Properties.LicenseModel = pointer.To(exadbvmclusters.LicenseModel(model.LicenseModel))
+			}
+			if len(model.NetworkSecurityGroupCidrs) > 0 {
+				param.Properties.NsgCidrs = expandExadbNsgCidrs(model.NetworkSecurityGroupCidrs)
+			}
+			if model.PrivateZoneOcid != "" {
+				param.Properties.PrivateZoneOcid = pointer.To(model.PrivateZoneOcid)
+			}
+			if model.SystemVersion != "" {
+				param.Properties.SystemVersion = pointer.To(model.SystemVersion)
+			}
+			if model.TimeZone != "" {
+				param.Properties.TimeZone = pointer.To(model.TimeZone)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ExadbVmClusterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExadbVMClusters
+
+			id, err := exadbvmclusters.ParseExadbVMClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ExadbVmClusterResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			update := exadbvmclusters.ExadbVMClusterUpdate{}
+			if metadata.ResourceData.HasChange("tags") {
+				update.Tags = pointer.To(model.Tags)
+			}
+			if metadata.ResourceData.HasChange("node_count") {
+				update.Properties = &exadbvmclusters.ExadbVMClusterUpdateProperties{
+					NodeCount: pointer.To(model.NodeCount),
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ExadbVmClusterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExadbVMClusters
+
+			id, err := exadbvmclusters.ParseExadbVMClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ExadbVmClusterResourceModel{
+				Name:              id.ExadbVmClusterName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+				state.Zones = pointer.From(model.Zones)
+
+				if props := model.Properties; props != nil {
+					state.DisplayName = props.DisplayName
+					state.EnabledEcpuCount = props.EnabledEcpuCount
+					state.ExascaleDatabaseStorageVaultId = props.ExascaleDbStorageVaultId
+					state.Hostname = removeHostnameSuffix(props.Hostname)
+					state.HostnameActual = props.Hostname
+					state.NodeCount = props.NodeCount
+					state.Shape = props.Shape
+					sshPublicKeys := make([]string, 0)
+					for _, key := range props.SshPublicKeys {
+						if key != "" {
+							sshPublicKeys = append(sshPublicKeys, key)
+						}
+					}
+					state.SshPublicKeys = sshPublicKeys
+					state.SubnetId = props.SubnetId
+					state.TotalEcpuCount = props.TotalEcpuCount
+					state.VirtualMachineFileSystemInGbs = props.VMFileSystemStorage.TotalSizeInGbs
+					state.VnetId = props.VnetId
+
+					// Optional
+					state.BackupSubnetCidr = pointer.From(props.BackupSubnetCidr)
+					state.ClusterName = pointer.From(props.ClusterName)
+					state.DataCollectionOptions = flattenExadbDataCollectionOptions(props.DataCollectionOptions)
+					state.Domain = pointer.From(props.Domain)
+					state.GridImageOcid = pointer.From(props.GridImageOcid)
+					state.LicenseModel = string(pointer.From(props.LicenseModel))
+					state.NetworkSecurityGroupCidrs = flattenExadbNsgCidrs(props.NsgCidrs)
+					state.PrivateZoneOcid = pointer.From(props.PrivateZoneOcid)
+					state.ScanListenerPortTcp = pointer.From(props.ScanListenerPortTcp)
+					state.ScanListenerPortTcpSsl = pointer.From(props.ScanListenerPortTcpSsl)
+					state.SystemVersion = pointer.From(props.SystemVersion)
+					state.TimeZone = pointer.From(props.TimeZone)
+
+					// Computed
+					state.GiVersion = pointer.From(props.GiVersion)
+					state.MemorySizeInGbs = pointer.From(props.MemorySizeInGbs)
+					state.Ocid = pointer.From(props.Ocid)
+					state.ScanDnsName = pointer.From(props.ScanDnsName)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ExadbVmClusterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExadbVMClusters
+
+			id, err := exadbvmclusters.ParseExadbVMClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ExadbVmClusterResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the update API can only add nodes, removing them requires the specific VMs to be chosen
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("node_count"); newVal.(int) < oldVal.(int) {
+				if err := metadata.ResourceDiff.ForceNew("node_count"); err != nil {
+					return err
+				}
+			}
+
+			for i, raw := range metadata.ResourceDiff.Get("network_security_group_cidr").([]interface{}) {
+				v, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if (v["destination_port_range_min"].(int) == 0) != (v["destination_port_range_max"].(int) == 0) {
+					return fmt.Errorf("`destination_port_range_min` and `destination_port_range_max` must be specified together in `network_security_group_cidr.%d`", i)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ExadbVmClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return exadbvmclusters.ValidateExadbVMClusterID
+}
+
+func expandExadbNsgCidrs(input []ExadbNsgCidrModel) *[]exadbvmclusters.NsgCidr {
+	output := make([]exadbvmclusters.NsgCidr, 0, len(input))
+	for _, item := range input {
+		nsgCidr := exadbvmclusters.NsgCidr{
+			Source: item.Source,
+		}
+		if item.DestinationPortRangeMin != 0 || item.DestinationPortRangeMax != 0 {
+			nsgCidr.DestinationPortRange = &exadbvmclusters.PortRange{
+				Min: item.DestinationPortRangeMin,
+				Max: item.DestinationPortRangeMax,
+			}
+		}
+		output = append(output, nsgCidr)
+	}
+	return &output
+}
+
+func flattenExadbNsgCidrs(input *[]exadbvmclusters.NsgCidr) []ExadbNsgCidrModel {
+	output := make([]ExadbNsgCidrModel, 0)
+	if input != nil {
+		for _, item := range *input {
+			nsgCidr := ExadbNsgCidrModel{
+				Source: item.Source,
+			}
+			if portRange := item.DestinationPortRange; portRange != nil {
+				nsgCidr.DestinationPortRangeMin = portRange.Min
+				nsgCidr.DestinationPortRangeMax = portRange.Max
+			}
+			output = append(output, nsgCidr)
+		}
+	}
+	return output
+}
+
+func flattenExadbDataCollectionOptions(input *exadbvmclusters.DataCollectionOptions) []DataCollectionOptionsModel {
+	output := make([]DataCollectionOptionsModel, 0)
+	if input != nil {
+		return append(output, DataCollectionOptionsModel{
+			IsDiagnosticsEventsEnabled: pointer.From(input.IsDiagnosticsEventsEnabled),
+			IsHealthMonitoringEnabled:  pointer.From(input.IsHealthMonitoringEnabled),
+			IsIncidentLogsEnabled:      pointer.From(input.IsIncidentLogsEnabled),
+		})
+	}
+	return output
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/exadbvmclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ExadbVmClusterResource struct{}
+
+func (a ExadbVmClusterResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := exadbvmclusters.ParseExadbVMClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Oracle.OracleClient.ExadbVMClusters.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestExadbVmClusterResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.ExadbVmClusterResource{}.ResourceType(), "test")
+	r := ExadbVmClusterResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestExadbVmClusterResource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.ExadbVmClusterResource{}.ResourceType(), "test")
+	r := ExadbVmClusterResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestExadbVmClusterResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.ExadbVmClusterResource{}.ResourceType(), "test")
+	r := ExadbVmClusterResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestExadbVmClusterResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.ExadbVmClusterResource{}.ResourceType(), "test")
+	r := ExadbVmClusterResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (a ExadbVmClusterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadb_vm_cluster" "test" {
+  name                                       = "OFakeVmacctest%[2]d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = "%[3]s"
+  zones                                      = ["2"]
+  display_name                               = "OFakeVmacctest%[2]d"
+  enabled_ecpu_count                         = 16
+  exascale_database_storage_vault_id         = azurerm_oracle_exascale_database_storage_vault.test.id
+  hostname                                   = "hostname"
+  node_count                                 = 2
+  shape                                      = "EXADBXS"
+  ssh_public_keys                            = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com"]
+  subnet_id                                  = azurerm_subnet.virtual_network_subnet.id
+  total_ecpu_count                           = 24
+  virtual_machine_file_system_storage_in_gbs = 260
+  virtual_network_id                         = azurerm_virtual_network.virtual_network.id
+}
+`, a.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (a ExadbVmClusterResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadb_vm_cluster" "test" {
+  name                                       = "OFakeVmacctest%[2]d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = "%[3]s"
+  zones                                      = ["2"]
+  display_name                               = "OFakeVmacctest%[2]d"
+  enabled_ecpu_count                         = 16
+  exascale_database_storage_vault_id         = azurerm_oracle_exascale_database_storage_vault.test.id
+  hostname                                   = "hostname"
+  node_count                                 = 2
+  shape                                      = "EXADBXS"
+  ssh_public_keys                            = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com"]
+  subnet_id                                  = azurerm_subnet.virtual_network_subnet.id
+  total_ecpu_count                           = 24
+  virtual_machine_file_system_storage_in_gbs = 260
+  virtual_network_id                         = azurerm_virtual_network.virtual_network.id
+  backup_subnet_cidr                         = "172.17.5.0/24"
+  cluster_name                               = "acctest%[4]s"
+  license_model                              = "BringYourOwnLicense"
+  scan_listener_port_tcp                     = 1521
+  scan_listener_port_tcp_ssl                 = 2484
+  time_zone                                  = "UTC"
+
+  data_collection_options {
+    diagnostics_events_enabled = true
+    health_monitoring_enabled  = true
+    incident_logs_enabled      = true
+  }
+
+  network_security_group_cidr {
+    source                     = "10.0.0.0/16"
+    destination_port_range_min = 1521
+    destination_port_range_max = 1522
+  }
+
+  tags = {
+    test = "testTag1"
+  }
+}
+`, a.template(data), data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (a ExadbVmClusterResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadb_vm_cluster" "test" {
+  name                                       = "OFakeVmacctest%[2]d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = "%[3]s"
+  zones                                      = ["2"]
+  display_name                               = "OFakeVmacctest%[2]d"
+  enabled_ecpu_count                         = 16
+  exascale_database_storage_vault_id         = azurerm_oracle_exascale_database_storage_vault.test.id
+  hostname                                   = "hostname"
+  node_count                                 = 3
+  shape                                      = "EXADBXS"
+  ssh_public_keys                            = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com"]
+  subnet_id                                  = azurerm_subnet.virtual_network_subnet.id
+  total_ecpu_count                           = 24
+  virtual_machine_file_system_storage_in_gbs = 260
+  virtual_network_id                         = azurerm_virtual_network.virtual_network.id
+
+  tags = {
+    test = "testTag2"
+  }
+}
+`, a.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (a ExadbVmClusterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_exadb_vm_cluster" "import" {
+  name                                       = azurerm_oracle_exadb_vm_cluster.test.name
+  resource_group_name                        = azurerm_oracle_exadb_vm_cluster.test.resource_group_name
+  location                                   = azurerm_oracle_exadb_vm_cluster.test.location
+  zones                                      = azurerm_oracle_exadb_vm_cluster.test.zones
+  display_name                               = azurerm_oracle_exadb_vm_cluster.test.display_name
+  enabled_ecpu_count                         = azurerm_oracle_exadb_vm_cluster.test.enabled_ecpu_count
+  exascale_database_storage_vault_id         = azurerm_oracle_exadb_vm_cluster.test.exascale_database_storage_vault_id
+  hostname                                   = azurerm_oracle_exadb_vm_cluster.test.hostname
+  node_count                                 = azurerm_oracle_exadb_vm_cluster.test.node_count
+  shape                                      = azurerm_oracle_exadb_vm_cluster.test.shape
+  ssh_public_keys                            = azurerm_oracle_exadb_vm_cluster.test.ssh_public_keys
+  subnet_id                                  = azurerm_oracle_exadb_vm_cluster.test.subnet_id
+  total_ecpu_count                           = azurerm_oracle_exadb_vm_cluster.test.total_ecpu_count
+  virtual_machine_file_system_storage_in_gbs = azurerm_oracle_exadb_vm_cluster.test.virtual_machine_file_system_storage_in_gbs
+  virtual_network_id                         = azurerm_oracle_exadb_vm_cluster.test.virtual_network_id
+}
+`, a.basic(data))
+}
+
+func (a ExadbVmClusterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "virtual_network" {
+  name                = "OFakeacctest%[1]d_vnet"
+  address_space       = ["10.0.0.0/16"]
+  location            = "%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "virtual_network_subnet" {
+  name                 = "OFakeacctest%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.virtual_network.name
+  address_prefixes     = ["10.0.1.0/24"]
+
+  delegation {
+    name = "delegation"
+
+    service_delegation {
+      actions = [
+        "Microsoft.Network/networkinterfaces/*",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+      ]
+      name = "Oracle.Database/networkAttachments"
+    }
+  }
+}
+
+resource "azurerm_oracle_exascale_database_storage_vault" "test" {
+  name                                  = "OFakeacctest%[1]d"
+  resource_group_name                   = azurerm_resource_group.test.name
+  location                              = "%[2]s"
+  zones                                 = ["2"]
+  display_name                          = "OFakeacctest%[1]d"
+  high_capacity_database_storage_in_gbs = 300
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
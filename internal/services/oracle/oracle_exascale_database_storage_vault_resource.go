@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/exascaledbstoragevaults"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ExascaleDatabaseStorageVaultResource{}
+
+type ExascaleDatabaseStorageVaultResource struct{}
+
+type ExascaleDatabaseStorageVaultResourceModel struct {
+	// Azure
+	Location          string            `tfschema:"location"`
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Tags              map[string]string `tfschema:"tags"`
+	Zones             zones.Schema      `tfschema:"zones"`
+
+	// Required
+	DisplayName                      string `tfschema:"display_name"`
+	HighCapacityDatabaseStorageInGbs int64  `tfschema:"high_capacity_database_storage_in_gbs"`
+
+	// Optional
+	AdditionalFlashCachePercentage int64  `tfschema:"additional_flash_cache_percentage"`
+	Description                    string `tfschema:"description"`
+	TimeZone                       string `tfschema:"time_zone"`
+
+	// Computed
+	AvailableHighCapacityDatabaseStorageInGbs int64  `tfschema:"available_high_capacity_database_storage_in_gbs"`
+	Ocid                                      string `tfschema:"ocid"`
+	VmClusterCount                            int64  `tfschema:"vm_cluster_count"`
+}
+
+func (ExascaleDatabaseStorageVaultResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ExascaleDatabaseStorageVaultName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		// Required
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ExascaleDatabaseStorageVaultName,
+		},
+
+		"high_capacity_database_storage_in_gbs": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"zones": commonschema.ZonesMultipleRequiredForceNew(),
+
+		// Optional
+		"additional_flash_cache_percentage": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 400),
+		},
+
+		"time_zone": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (ExascaleDatabaseStorageVaultResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"available_high_capacity_database_storage_in_gbs": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"ocid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"vm_cluster_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (ExascaleDatabaseStorageVaultResource) ModelObject() interface{} {
+	return &ExascaleDatabaseStorageVaultResourceModel{}
+}
+
+func (ExascaleDatabaseStorageVaultResource) ResourceType() string {
+	return "azurerm_oracle_exascale_database_storage_vault"
+}
+
+func (r ExascaleDatabaseStorageVaultResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExascaleDbStorageVaults
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ExascaleDatabaseStorageVaultResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := exascaledbstoragevaults.NewExascaleDbStorageVaultID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := exascaledbstoragevaults.ExascaleDbStorageVault{
+				Name:     pointer.To(model.Name),
+				Location: location.Normalize(model.Location),
+				Tags:     pointer.To(model.Tags),
+				Zones:    pointer.To(model.Zones),
+				Properties: &exascaledbstoragevaults.ExascaleDbStorageVaultProperties{
+					DisplayName: model.DisplayName,
+					HighCapacityDatabaseStorageInput: exascaledbstoragevaults.ExascaleDbStorageInputDetails{
+						TotalSizeInGbs: model.HighCapacityDatabaseStorageInGbs,
+					},
+				},
+			}
+
+			if model.AdditionalFlashCachePercentage != 0 {
+				param.Properties.AdditionalFlashCacheInPercent = pointer.To(model.AdditionalFlashCachePercentage)
+			}
+			if model.Description != "" {
+				param.Properties.Description = pointer.To(model.Description)
+			}
+			if model.TimeZone != "" {
+				param.Properties.TimeZone = pointer.To(model.TimeZone)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ExascaleDatabaseStorageVaultResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExascaleDbStorageVaults
+
+			id, err := exascaledbstoragevaults.ParseExascaleDbStorageVaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ExascaleDatabaseStorageVaultResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				update := exascaledbstoragevaults.ExascaleDbStorageVaultTagsUpdate{
+					Tags: pointer.To(model.Tags),
+				}
+				if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ExascaleDatabaseStorageVaultResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExascaleDbStorageVaults
+
+			id, err := exascaledbstoragevaults.ParseExascaleDbStorageVaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ExascaleDatabaseStorageVaultResourceModel{
+				Name:              id.ExascaleDbStorageVaultName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+				state.Zones = pointer.From(model.Zones)
+
+				if props := model.Properties; props != nil {
+					state.DisplayName = props.DisplayName
+					state.AdditionalFlashCachePercentage = pointer.From(props.AdditionalFlashCacheInPercent)
+					state.Description = pointer.From(props.Description)
+					state.Ocid = pointer.From(props.Ocid)
+					state.TimeZone = pointer.From(props.TimeZone)
+					state.VmClusterCount = pointer.From(props.VMClusterCount)
+
+					state.HighCapacityDatabaseStorageInGbs = props.HighCapacityDatabaseStorageInput.TotalSizeInGbs
+					if storage := props.HighCapacityDatabaseStorage; storage != nil {
+						// the input block isn't always returned by the API, so fall back to the provisioned size
+						if state.HighCapacityDatabaseStorageInGbs == 0 {
+							state.HighCapacityDatabaseStorageInGbs = pointer.From(storage.TotalSizeInGbs)
+						}
+						state.AvailableHighCapacityDatabaseStorageInGbs = pointer.From(storage.AvailableSizeInGbs)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (ExascaleDatabaseStorageVaultResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Oracle.OracleClient.ExascaleDbStorageVaults
+
+			id, err := exascaledbstoragevaults.ParseExascaleDbStorageVaultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ExascaleDatabaseStorageVaultResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return exascaledbstoragevaults.ValidateExascaleDbStorageVaultID
+}
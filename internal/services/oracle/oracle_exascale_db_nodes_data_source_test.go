@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExascaleDbNodesDataSource struct{}
+
+func TestExascaleDbNodesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_oracle_exascale_db_nodes", "test")
+	r := ExascaleDbNodesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("db_nodes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("db_nodes.0.ocid").Exists(),
+			),
+		},
+	})
+}
+
+func (d ExascaleDbNodesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_oracle_exascale_db_nodes" "test" {
+  exadb_vm_cluster_id = azurerm_oracle_exadb_vm_cluster.test.id
+}
+`, ExadbVmClusterResource{}.basic(data))
+}
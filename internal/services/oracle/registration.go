@@ -24,6 +24,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 		GiVersionsDataSource{},
 		AutonomousDatabaseBackupDataSource{},
 		AutonomousDatabaseBackupsDataSource{},
+		DnsPrivateViewsDataSource{},
+		DnsPrivateZonesDataSource{},
+		ExascaleDbNodesDataSource{},
 	}
 }
 
@@ -33,6 +36,8 @@ func (r Registration) Resources() []sdk.Resource {
 		CloudVmClusterResource{},
 		ExadataInfraResource{},
 		AutonomousDatabaseBackupResource{},
+		ExadbVmClusterResource{},
+		ExascaleDatabaseStorageVaultResource{},
 	}
 }
 
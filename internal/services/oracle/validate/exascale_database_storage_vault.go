@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func ExascaleDatabaseStorageVaultName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(v) < 1 || len(v) > 255 {
+		errors = append(errors, fmt.Errorf("%s must be %d to %d characters", k, 1, 255))
+		return
+	}
+
+	firstChar, _ := utf8.DecodeRuneInString(v)
+	if !unicode.IsLetter(firstChar) && firstChar != '_' {
+		errors = append(errors, fmt.Errorf("%s must start with a letter or underscore (_)", k))
+		return
+	}
+
+	if strings.Contains(v, "--") {
+		errors = append(errors, fmt.Errorf("%s must not contain any consecutive hyphens (--)", k))
+		return
+	}
+
+	return
+}
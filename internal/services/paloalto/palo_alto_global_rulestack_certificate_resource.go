@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/certificateobjectglobalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/globalrulestack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyvaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type GlobalRuleStackCertificate struct{}
+
+var _ sdk.ResourceWithUpdate = GlobalRuleStackCertificate{}
+
+type GlobalRuleStackCertificateModel struct {
+	Name                string `tfschema:"name"`
+	RuleStackID         string `tfschema:"rulestack_id"`
+	AuditComment        string `tfschema:"audit_comment"`
+	CertificateSignerID string `tfschema:"key_vault_certificate_id"`
+	Description         string `tfschema:"description"`
+	SelfSigned          bool   `tfschema:"self_signed"`
+}
+
+func (r GlobalRuleStackCertificate) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return certificateobjectglobalrulestack.ValidateCertificateID
+}
+
+func (r GlobalRuleStackCertificate) ResourceType() string {
+	return "azurerm_palo_alto_global_rulestack_certificate"
+}
+
+func (r GlobalRuleStackCertificate) Arguments() map[string]*schema.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GlobalRuleStackCertificateName,
+		},
+
+		"rulestack_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: certificateobjectglobalrulestack.ValidateGlobalRulestackID,
+		},
+
+		"audit_comment": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"key_vault_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: keyvaultValidate.VersionlessNestedItemId,
+			ExactlyOneOf: []string{"self_signed", "key_vault_certificate_id"},
+		},
+
+		"self_signed": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      false,
+			ExactlyOneOf: []string{"key_vault_certificate_id", "self_signed"},
+		},
+	}
+}
+
+func (r GlobalRuleStackCertificate) Attributes() map[string]*schema.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GlobalRuleStackCertificate) ModelObject() interface{} {
+	return &GlobalRuleStackCertificateModel{}
+}
+
+func (r GlobalRuleStackCertificate) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.CertificateObjectGlobalRulestack
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			model := GlobalRuleStackCertificateModel{}
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			rulestackId, err := globalrulestack.ParseGlobalRulestackID(model.RuleStackID)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			id := certificateobjectglobalrulestack.NewCertificateID(rulestackId.GlobalRulestackName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := certificateobjectglobalrulestack.CertificateObject{
+				CertificateSelfSigned: boolAsBooleanEnumGlobalCert(model.SelfSigned),
+			}
+
+			if model.AuditComment != "" {
+				props.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if model.CertificateSignerID != "" {
+				props.CertificateSignerResourceId = pointer.To(model.CertificateSignerID)
+			}
+
+			if model.Description != "" {
+				props.Description = pointer.To(model.Description)
+			}
+
+			cert := certificateobjectglobalrulestack.CertificateObjectGlobalRulestackResource{
+				Properties: props,
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, id, cert); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err = rulestackClient.CommitThenPoll(ctx, *rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackCertificate) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.CertificateObjectGlobalRulestack
+
+			id, err := certificateobjectglobalrulestack.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GlobalRuleStackCertificateModel
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state.Name = id.CertificateName
+			state.RuleStackID = certificateobjectglobalrulestack.NewGlobalRulestackID(id.GlobalRulestackName).ID()
+
+			if model := existing.Model; model != nil {
+				props := model.Properties
+
+				state.AuditComment = pointer.From(props.AuditComment)
+				state.CertificateSignerID = pointer.From(props.CertificateSignerResourceId)
+				state.Description = pointer.From(props.Description)
+				state.SelfSigned = boolEnumAsBoolGlobalCert(props.CertificateSelfSigned)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GlobalRuleStackCertificate) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.CertificateObjectGlobalRulestack
+
+			id, err := certificateobjectglobalrulestack.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			if _, err = client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackCertificate) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.CertificateObjectGlobalRulestack
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+			model := GlobalRuleStackCertificateModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id, err := certificateobjectglobalrulestack.ParseCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			cert := *existing.Model
+
+			if metadata.ResourceData.HasChange("description") {
+				cert.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("audit_comment") {
+				cert.Properties.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if metadata.ResourceData.HasChanges("key_vault_certificate_id", "self_signed") {
+				cert.Properties.CertificateSelfSigned = boolAsBooleanEnumGlobalCert(model.SelfSigned)
+				cert.Properties.CertificateSignerResourceId = pointer.To(model.CertificateSignerID)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, *id, cert); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func boolAsBooleanEnumGlobalCert(input bool) certificateobjectglobalrulestack.BooleanEnum {
+	if input {
+		return certificateobjectglobalrulestack.BooleanEnumTRUE
+	}
+
+	return certificateobjectglobalrulestack.BooleanEnumFALSE
+}
+
+func boolEnumAsBoolGlobalCert(input certificateobjectglobalrulestack.BooleanEnum) bool {
+	return input == certificateobjectglobalrulestack.BooleanEnumTRUE
+}
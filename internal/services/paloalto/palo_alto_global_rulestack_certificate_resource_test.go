@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/certificateobjectglobalrulestack"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type GlobalRulestackCertificate struct{}
+
+func TestAccPaloAltoGlobalRulestackCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_certificate", "test")
+
+	r := GlobalRulestackCertificate{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackCertificate_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_certificate", "test")
+
+	r := GlobalRulestackCertificate{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackCertificate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_certificate", "test")
+
+	r := GlobalRulestackCertificate{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_certificate", "test")
+
+	r := GlobalRulestackCertificate{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r GlobalRulestackCertificate) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := certificateobjectglobalrulestack.ParseCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.PaloAlto.PaloAltoClient_v2025_05_23.CertificateObjectGlobalRulestack.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r GlobalRulestackCertificate) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_palo_alto_global_rulestack_certificate" "test" {
+  name         = "testacc-pagc-%[2]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+  self_signed  = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GlobalRulestackCertificate) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_palo_alto_global_rulestack_certificate" "test" {
+  name         = "testacc-pagc-%[2]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+  self_signed  = true
+
+  audit_comment = "Acc Test Audit Comment - %[2]d"
+  description   = "Acc Test Description - %[2]d"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GlobalRulestackCertificate) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_palo_alto_global_rulestack_certificate" "import" {
+  name         = azurerm_palo_alto_global_rulestack_certificate.test.name
+  rulestack_id = azurerm_palo_alto_global_rulestack_certificate.test.rulestack_id
+  self_signed  = azurerm_palo_alto_global_rulestack_certificate.test.self_signed
+}
+`, r.basic(data))
+}
+
+func (r GlobalRulestackCertificate) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_palo_alto_global_rulestack" "test" {
+  name     = "testAcc-pagrs-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
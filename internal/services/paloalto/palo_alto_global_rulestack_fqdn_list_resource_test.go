@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/fqdnlistglobalrulestack"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type GlobalRulestackFQDNList struct{}
+
+func TestAccPaloAltoGlobalRulestackFQDNList_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_fqdn_list", "test")
+
+	r := GlobalRulestackFQDNList{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackFQDNList_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_fqdn_list", "test")
+
+	r := GlobalRulestackFQDNList{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackFQDNList_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_fqdn_list", "test")
+
+	r := GlobalRulestackFQDNList{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackFQDNList_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_fqdn_list", "test")
+
+	r := GlobalRulestackFQDNList{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r GlobalRulestackFQDNList) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := fqdnlistglobalrulestack.ParseFqdnListID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.PaloAlto.PaloAltoClient_v2025_05_23.FqdnListGlobalRulestack.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r GlobalRulestackFQDNList) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_palo_alto_global_rulestack_fqdn_list" "test" {
+  name         = "testacc-pagfqdn-%[2]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+
+  fully_qualified_domain_names = ["contoso.com", "test.example.com"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GlobalRulestackFQDNList) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_palo_alto_global_rulestack_fqdn_list" "test" {
+  name         = "testacc-pagfqdn-%[2]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+
+  fully_qualified_domain_names = ["contoso.com", "test.example.com", "anothertest.example.com"]
+
+  audit_comment = "Acc Test Audit Comment - %[2]d"
+  description   = "Acc Test Description - %[2]d"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GlobalRulestackFQDNList) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_palo_alto_global_rulestack_fqdn_list" "import" {
+  name         = azurerm_palo_alto_global_rulestack_fqdn_list.test.name
+  rulestack_id = azurerm_palo_alto_global_rulestack_fqdn_list.test.rulestack_id
+
+  fully_qualified_domain_names = azurerm_palo_alto_global_rulestack_fqdn_list.test.fully_qualified_domain_names
+}
+`, r.basic(data))
+}
+
+func (r GlobalRulestackFQDNList) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_palo_alto_global_rulestack" "test" {
+  name     = "testAcc-pagrs-%[1]d"
+  location = "%[2]s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
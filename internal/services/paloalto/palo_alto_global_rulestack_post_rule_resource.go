@@ -0,0 +1,674 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/certificateobjectglobalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/globalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/postrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GlobalRuleStackPostRule struct{}
+
+var _ sdk.ResourceWithUpdate = GlobalRuleStackPostRule{}
+
+type GlobalRuleStackPostRuleModel struct {
+	Name        string `tfschema:"name"`
+	RuleStackID string `tfschema:"rulestack_id"`
+	Priority    int64  `tfschema:"priority"`
+
+	Action                  string                     `tfschema:"action"`
+	Applications            []string                   `tfschema:"applications"`
+	AuditComment            string                     `tfschema:"audit_comment"`
+	Category                []schema.Category          `tfschema:"category"`
+	DecryptionRuleType      string                     `tfschema:"decryption_rule_type"`
+	Description             string                     `tfschema:"description"`
+	Destination             []schema.GlobalDestination `tfschema:"destination"`
+	LoggingEnabled          bool                       `tfschema:"logging_enabled"`
+	InspectionCertificateID string                     `tfschema:"inspection_certificate_id"` // This is the name of a Certificate resource belonging to the SAME GlobalRuleStack as this rule
+	NegateDestination       bool                       `tfschema:"negate_destination"`
+	NegateSource            bool                       `tfschema:"negate_source"`
+	Protocol                string                     `tfschema:"protocol"`
+	ProtocolPorts           []string                   `tfschema:"protocol_ports"`
+	RuleEnabled             bool                       `tfschema:"enabled"`
+	Source                  []schema.GlobalSource      `tfschema:"source"`
+	Tags                    map[string]interface{}     `tfschema:"tags"`
+}
+
+func (r GlobalRuleStackPostRule) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return postrules.ValidatePostRuleID
+}
+
+func (r GlobalRuleStackPostRule) ResourceType() string {
+	return "azurerm_palo_alto_global_rulestack_post_rule"
+}
+
+func (r GlobalRuleStackPostRule) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.GlobalRuleStackRuleName,
+		},
+
+		"rulestack_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: postrules.ValidateGlobalRulestackID,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(postrules.PossibleValuesForActionEnum(), false),
+		},
+
+		"applications": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"audit_comment": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"category": schema.CategorySchema(),
+
+		"decryption_rule_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      postrules.DecryptionRuleTypeEnumNone,
+			ValidateFunc: validation.StringInSlice(postrules.PossibleValuesForDecryptionRuleTypeEnum(), false),
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"destination": schema.GlobalDestinationSchema(),
+
+		"logging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"inspection_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: certificateobjectglobalrulestack.ValidateCertificateID,
+		},
+
+		"negate_destination": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"negate_source": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validate.ProtocolWithPort,
+				validation.StringInSlice([]string{protocolApplicationDefault}, false),
+			),
+			ExactlyOneOf: []string{"protocol", "protocol_ports"},
+		},
+
+		"protocol_ports": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ProtocolWithPort,
+			},
+			ExactlyOneOf: []string{"protocol", "protocol_ports"},
+		},
+
+		"source": schema.GlobalSourceSchema(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r GlobalRuleStackPostRule) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GlobalRuleStackPostRule) ModelObject() interface{} {
+	return &GlobalRuleStackPostRuleModel{}
+}
+
+func (r GlobalRuleStackPostRule) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PostRules
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			model := GlobalRuleStackPostRuleModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			rulestackId, err := globalrulestack.ParseGlobalRulestackID(model.RuleStackID)
+			if err != nil {
+				return err
+			}
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			// As with Local Rules, the API uses Priority rather than Name for the ID
+			id := postrules.NewPostRuleID(rulestackId.GlobalRulestackName, strconv.FormatInt(model.Priority, 10))
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			destination, err := expandPostRuleDestination(model.Destination)
+			if err != nil {
+				return fmt.Errorf("expanding destination for %s, %+v", id, err)
+			}
+
+			source, err := expandPostRuleSource(model.Source)
+			if err != nil {
+				return fmt.Errorf("expanding source for %s: %+v", id, err)
+			}
+
+			props := postrules.RuleEntry{
+				Category:          expandPostRuleCategory(model.Category),
+				Destination:       destination,
+				EnableLogging:     boolAsStateEnumPostRule(model.LoggingEnabled),
+				NegateDestination: boolAsBooleanEnumPostRule(model.NegateDestination),
+				NegateSource:      boolAsBooleanEnumPostRule(model.NegateSource),
+				RuleName:          model.Name,
+				RuleState:         boolAsStateEnumPostRule(model.RuleEnabled),
+				Source:            source,
+				Tags:              expandTagsForPostRule(model.Tags),
+			}
+
+			if model.Action != "" {
+				props.ActionType = pointer.To(postrules.ActionEnum(model.Action))
+			}
+
+			if len(model.Applications) != 0 {
+				props.Applications = pointer.To(model.Applications)
+			}
+
+			if model.AuditComment != "" {
+				props.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if model.DecryptionRuleType != "" {
+				props.DecryptionRuleType = pointer.To(postrules.DecryptionRuleTypeEnum(model.DecryptionRuleType))
+			}
+
+			if model.Description != "" {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if model.InspectionCertificateID != "" {
+				certID, err := certificateobjectglobalrulestack.ParseCertificateID(model.InspectionCertificateID)
+				if err != nil {
+					return err
+				}
+				props.InboundInspectionCertificate = pointer.To(certID.CertificateName)
+			}
+
+			if model.Priority != 0 {
+				props.Priority = pointer.To(model.Priority)
+			}
+
+			if len(model.ProtocolPorts) != 0 {
+				props.ProtocolPortList = pointer.To(model.ProtocolPorts)
+			}
+
+			if model.Protocol != "" && !strings.EqualFold(model.Protocol, protocolApplicationDefault) && len(model.ProtocolPorts) == 0 {
+				props.Protocol = pointer.To(model.Protocol)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, id, postrules.PostRulesResource{Properties: props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err = rulestackClient.CommitThenPoll(ctx, *rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackPostRule) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PostRules
+
+			id, err := postrules.ParsePostRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GlobalRuleStackPostRuleModel
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state.RuleStackID = postrules.NewGlobalRulestackID(id.GlobalRulestackName).ID()
+			p, err := strconv.ParseInt(id.PostRuleName, 10, 0)
+			if err != nil {
+				return fmt.Errorf("parsing Rule Priority for %s: %+v", *id, err)
+			}
+			state.Priority = p
+			if model := existing.Model; model != nil {
+				props := model.Properties
+				state.Name = props.RuleName
+				state.Action = string(pointer.From(props.ActionType))
+				state.Applications = pointer.From(props.Applications)
+				state.AuditComment = pointer.From(props.AuditComment)
+				state.Category = flattenPostRuleCategory(props.Category)
+				state.DecryptionRuleType = string(pointer.From(props.DecryptionRuleType))
+				state.Description = pointer.From(props.Description)
+				state.Destination = flattenPostRuleDestination(props.Destination, *id)
+				state.LoggingEnabled = stateEnumAsBoolPostRule(props.EnableLogging)
+				if certName := pointer.From(props.InboundInspectionCertificate); certName != "" {
+					state.InspectionCertificateID = certificateobjectglobalrulestack.NewCertificateID(id.GlobalRulestackName, certName).ID()
+				}
+				state.NegateDestination = boolEnumAsBoolPostRule(props.NegateDestination)
+				state.NegateSource = boolEnumAsBoolPostRule(props.NegateSource)
+				state.Protocol = pointer.From(props.Protocol)
+				state.ProtocolPorts = pointer.From(props.ProtocolPortList)
+				state.RuleEnabled = stateEnumAsBoolPostRule(props.RuleState)
+				state.Source = flattenPostRuleSource(props.Source, *id)
+				state.Tags = flattenTagsFromPostRule(props.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GlobalRuleStackPostRule) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PostRules
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := postrules.ParsePostRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackPostRule) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PostRules
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			model := GlobalRuleStackPostRuleModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id, err := postrules.ParsePostRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			ruleEntry := *existing.Model
+
+			if metadata.ResourceData.HasChange("name") {
+				ruleEntry.Properties.RuleName = model.Name
+			}
+
+			if metadata.ResourceData.HasChange("action") {
+				ruleEntry.Properties.ActionType = pointer.To(postrules.ActionEnum(model.Action))
+			}
+
+			if metadata.ResourceData.HasChange("applications") {
+				ruleEntry.Properties.Applications = pointer.To(model.Applications)
+			}
+
+			if metadata.ResourceData.HasChange("audit_comment") {
+				ruleEntry.Properties.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if metadata.ResourceData.HasChange("category") {
+				ruleEntry.Properties.Category = expandPostRuleCategory(model.Category)
+			}
+
+			if metadata.ResourceData.HasChange("decryption_rule_type") {
+				ruleEntry.Properties.DecryptionRuleType = pointer.To(postrules.DecryptionRuleTypeEnum(model.DecryptionRuleType))
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				ruleEntry.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("destination") {
+				destination, err := expandPostRuleDestination(model.Destination)
+				if err != nil {
+					return fmt.Errorf("expanding destination for %s, %+v", id, err)
+				}
+				ruleEntry.Properties.Destination = destination
+			}
+
+			if metadata.ResourceData.HasChange("logging_enabled") {
+				ruleEntry.Properties.EnableLogging = boolAsStateEnumPostRule(model.LoggingEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("inspection_certificate_id") {
+				if model.InspectionCertificateID != "" {
+					certID, err := certificateobjectglobalrulestack.ParseCertificateID(model.InspectionCertificateID)
+					if err != nil {
+						return err
+					}
+					ruleEntry.Properties.InboundInspectionCertificate = pointer.To(certID.CertificateName)
+				} else {
+					ruleEntry.Properties.InboundInspectionCertificate = pointer.To("")
+				}
+			}
+
+			if metadata.ResourceData.HasChange("negate_destination") {
+				ruleEntry.Properties.NegateDestination = boolAsBooleanEnumPostRule(model.NegateDestination)
+			}
+
+			if metadata.ResourceData.HasChange("negate_source") {
+				ruleEntry.Properties.NegateSource = boolAsBooleanEnumPostRule(model.NegateSource)
+			}
+
+			if metadata.ResourceData.HasChange("protocol") {
+				if model.Protocol != "" && !strings.EqualFold(model.Protocol, protocolApplicationDefault) && len(model.ProtocolPorts) == 0 {
+					ruleEntry.Properties.Protocol = pointer.To(model.Protocol)
+				} else {
+					ruleEntry.Properties.Protocol = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("protocol_ports") {
+				if len(model.ProtocolPorts) != 0 {
+					ruleEntry.Properties.ProtocolPortList = pointer.To(model.ProtocolPorts)
+				} else {
+					ruleEntry.Properties.ProtocolPortList = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				ruleEntry.Properties.RuleState = boolAsStateEnumPostRule(model.RuleEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("source") {
+				source, err := expandPostRuleSource(model.Source)
+				if err != nil {
+					return fmt.Errorf("expanding source for %s: %+v", id, err)
+				}
+				ruleEntry.Properties.Source = source
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				ruleEntry.Properties.Tags = expandTagsForPostRule(model.Tags)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, *id, ruleEntry); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPostRuleSource(input []schema.GlobalSource) (*postrules.SourceAddr, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	s := input[0]
+
+	prefixLists, err := schema.ExpandGlobalRulestackPrefixListIDs(s.PrefixLists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postrules.SourceAddr{
+		Cidrs:       pointer.To(s.CIDRS),
+		Countries:   pointer.To(s.Countries),
+		Feeds:       pointer.To(s.Feeds),
+		PrefixLists: pointer.To(prefixLists),
+	}, nil
+}
+
+func flattenPostRuleSource(input *postrules.SourceAddr, ruleId postrules.PostRuleId) []schema.GlobalSource {
+	if input == nil {
+		return []schema.GlobalSource{}
+	}
+
+	return []schema.GlobalSource{{
+		CIDRS:       pointer.From(input.Cidrs),
+		Countries:   pointer.From(input.Countries),
+		Feeds:       pointer.From(input.Feeds),
+		PrefixLists: schema.FlattenGlobalRulestackPrefixListIDs(ruleId.GlobalRulestackName, input.PrefixLists),
+	}}
+}
+
+func expandPostRuleDestination(input []schema.GlobalDestination) (*postrules.DestinationAddr, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	d := input[0]
+
+	prefixLists, err := schema.ExpandGlobalRulestackPrefixListIDs(d.PrefixLists)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdnLists, err := schema.ExpandGlobalRulestackFQDNListIDs(d.FQDNLists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postrules.DestinationAddr{
+		Cidrs:       pointer.To(d.CIDRS),
+		Countries:   pointer.To(d.Countries),
+		Feeds:       pointer.To(d.Feeds),
+		FqdnLists:   pointer.To(fqdnLists),
+		PrefixLists: pointer.To(prefixLists),
+	}, nil
+}
+
+func flattenPostRuleDestination(input *postrules.DestinationAddr, ruleId postrules.PostRuleId) []schema.GlobalDestination {
+	if input == nil {
+		return []schema.GlobalDestination{}
+	}
+
+	return []schema.GlobalDestination{{
+		CIDRS:       pointer.From(input.Cidrs),
+		Countries:   pointer.From(input.Countries),
+		Feeds:       pointer.From(input.Feeds),
+		FQDNLists:   schema.FlattenGlobalRulestackFQDNListIDs(ruleId.GlobalRulestackName, input.FqdnLists),
+		PrefixLists: schema.FlattenGlobalRulestackPrefixListIDs(ruleId.GlobalRulestackName, input.PrefixLists),
+	}}
+}
+
+func expandPostRuleCategory(input []schema.Category) *postrules.Category {
+	if len(input) == 0 {
+		return nil
+	}
+
+	c := input[0]
+
+	return &postrules.Category{
+		Feeds:     c.Feeds,
+		UrlCustom: c.CustomUrls,
+	}
+}
+
+func flattenPostRuleCategory(input *postrules.Category) []schema.Category {
+	if input == nil {
+		return []schema.Category{}
+	}
+
+	return []schema.Category{{
+		Feeds:      input.Feeds,
+		CustomUrls: input.UrlCustom,
+	}}
+}
+
+func boolAsStateEnumPostRule(input bool) *postrules.StateEnum {
+	var result postrules.StateEnum
+
+	if input {
+		result = postrules.StateEnumENABLED
+	} else {
+		result = postrules.StateEnumDISABLED
+	}
+
+	return pointer.To(result)
+}
+
+func stateEnumAsBoolPostRule(input *postrules.StateEnum) bool {
+	return pointer.From(input) == postrules.StateEnumENABLED
+}
+
+func boolAsBooleanEnumPostRule(input bool) *postrules.BooleanEnum {
+	var result postrules.BooleanEnum
+
+	if input {
+		result = postrules.BooleanEnumTRUE
+	} else {
+		result = postrules.BooleanEnumFALSE
+	}
+
+	return pointer.To(result)
+}
+
+func boolEnumAsBoolPostRule(input *postrules.BooleanEnum) bool {
+	return pointer.From(input) == postrules.BooleanEnumTRUE
+}
+
+func expandTagsForPostRule(input map[string]interface{}) *[]postrules.TagInfo {
+	result := make([]postrules.TagInfo, 0)
+	if len(input) == 0 {
+		return pointer.To(result)
+	}
+
+	for k, v := range input {
+		result = append(result, postrules.TagInfo{
+			Key:   k,
+			Value: v.(string),
+		})
+	}
+
+	return pointer.To(result)
+}
+
+func flattenTagsFromPostRule(input *[]postrules.TagInfo) map[string]interface{} {
+	if input == nil {
+		return map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	for _, v := range *input {
+		result[v.Key] = v.Value
+	}
+
+	return result
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/postrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type GlobalRulestackPostRuleResource struct{}
+
+func TestAccPaloAltoGlobalRulestackPostRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_post_rule", "test")
+
+	r := GlobalRulestackPostRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackPostRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_post_rule", "test")
+
+	r := GlobalRulestackPostRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackPostRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_post_rule", "test")
+
+	r := GlobalRulestackPostRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPaloAltoGlobalRulestackPostRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_global_rulestack_post_rule", "test")
+
+	r := GlobalRulestackPostRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GlobalRulestackPostRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := postrules.ParsePostRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.PaloAlto.PaloAltoClient_v2025_05_23.PostRules.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r GlobalRulestackPostRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_palo_alto_global_rulestack_post_rule" "test" {
+  name         = "testacc-pagpost-%[2]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+  priority     = 100
+  action       = "Allow"
+  protocol     = "application-default"
+
+  applications = ["any"]
+
+  destination {
+    cidrs = ["any"]
+  }
+
+  source {
+    cidrs = ["any"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GlobalRulestackPostRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_palo_alto_global_rulestack_post_rule" "import" {
+  name         = azurerm_palo_alto_global_rulestack_post_rule.test.name
+  rulestack_id = azurerm_palo_alto_global_rulestack_post_rule.test.rulestack_id
+  priority     = azurerm_palo_alto_global_rulestack_post_rule.test.priority
+  action       = azurerm_palo_alto_global_rulestack_post_rule.test.action
+  applications = azurerm_palo_alto_global_rulestack_post_rule.test.applications
+  protocol     = azurerm_palo_alto_global_rulestack_post_rule.test.protocol
+
+  destination {
+    cidrs = azurerm_palo_alto_global_rulestack_post_rule.test.destination.0.cidrs
+  }
+
+  source {
+    cidrs = azurerm_palo_alto_global_rulestack_post_rule.test.source.0.cidrs
+  }
+}
+`, r.basic(data))
+}
+
+func (r GlobalRulestackPostRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_palo_alto_global_rulestack_post_rule" "test" {
+  name         = "testacc-pagpost-%[2]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+  priority     = 100
+
+  action        = "DenySilent"
+  applications  = ["any"]
+  audit_comment = "test audit comment"
+
+  category {
+    custom_urls = ["hacking"]
+  }
+
+  description = "Acceptance Test Rule - dated %[2]d"
+
+  destination {
+    countries                        = ["US", "GB"]
+    global_rulestack_fqdn_list_ids   = [azurerm_palo_alto_global_rulestack_fqdn_list.test.id]
+    global_rulestack_prefix_list_ids = [azurerm_palo_alto_global_rulestack_prefix_list.test.id]
+  }
+
+  logging_enabled = true
+
+  negate_destination = true
+  negate_source      = true
+
+  protocol_ports = ["TCP:8080", "TCP:8081"]
+
+  enabled = false
+
+  source {
+    countries                        = ["US", "GB"]
+    global_rulestack_prefix_list_ids = [azurerm_palo_alto_global_rulestack_prefix_list.test.id]
+  }
+
+  tags = {
+    "acctest" = "true"
+    "foo"     = "bar"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GlobalRulestackPostRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_palo_alto_global_rulestack" "test" {
+  name     = "testAcc-pagrs-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_palo_alto_global_rulestack_fqdn_list" "test" {
+  name         = "testacc-pagfqdn-%[1]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+
+  fully_qualified_domain_names = ["contoso.com", "test.example.com"]
+}
+
+resource "azurerm_palo_alto_global_rulestack_prefix_list" "test" {
+  name         = "testacc-pagpl-%[1]d"
+  rulestack_id = azurerm_palo_alto_global_rulestack.test.id
+
+  prefix_list = ["10.0.0.0/8", "172.16.0.0/16"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
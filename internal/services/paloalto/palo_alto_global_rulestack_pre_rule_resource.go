@@ -0,0 +1,674 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/certificateobjectglobalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/globalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/prerules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GlobalRuleStackPreRule struct{}
+
+var _ sdk.ResourceWithUpdate = GlobalRuleStackPreRule{}
+
+type GlobalRuleStackPreRuleModel struct {
+	Name        string `tfschema:"name"`
+	RuleStackID string `tfschema:"rulestack_id"`
+	Priority    int64  `tfschema:"priority"`
+
+	Action                  string                     `tfschema:"action"`
+	Applications            []string                   `tfschema:"applications"`
+	AuditComment            string                     `tfschema:"audit_comment"`
+	Category                []schema.Category          `tfschema:"category"`
+	DecryptionRuleType      string                     `tfschema:"decryption_rule_type"`
+	Description             string                     `tfschema:"description"`
+	Destination             []schema.GlobalDestination `tfschema:"destination"`
+	LoggingEnabled          bool                       `tfschema:"logging_enabled"`
+	InspectionCertificateID string                     `tfschema:"inspection_certificate_id"` // This is the name of a Certificate resource belonging to the SAME GlobalRuleStack as this rule
+	NegateDestination       bool                       `tfschema:"negate_destination"`
+	NegateSource            bool                       `tfschema:"negate_source"`
+	Protocol                string                     `tfschema:"protocol"`
+	ProtocolPorts           []string                   `tfschema:"protocol_ports"`
+	RuleEnabled             bool                       `tfschema:"enabled"`
+	Source                  []schema.GlobalSource      `tfschema:"source"`
+	Tags                    map[string]interface{}     `tfschema:"tags"`
+}
+
+func (r GlobalRuleStackPreRule) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return prerules.ValidatePreRuleID
+}
+
+func (r GlobalRuleStackPreRule) ResourceType() string {
+	return "azurerm_palo_alto_global_rulestack_pre_rule"
+}
+
+func (r GlobalRuleStackPreRule) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.GlobalRuleStackRuleName,
+		},
+
+		"rulestack_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: prerules.ValidateGlobalRulestackID,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(prerules.PossibleValuesForActionEnum(), false),
+		},
+
+		"applications": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"audit_comment": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"category": schema.CategorySchema(),
+
+		"decryption_rule_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      prerules.DecryptionRuleTypeEnumNone,
+			ValidateFunc: validation.StringInSlice(prerules.PossibleValuesForDecryptionRuleTypeEnum(), false),
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"destination": schema.GlobalDestinationSchema(),
+
+		"logging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"inspection_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: certificateobjectglobalrulestack.ValidateCertificateID,
+		},
+
+		"negate_destination": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"negate_source": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validate.ProtocolWithPort,
+				validation.StringInSlice([]string{protocolApplicationDefault}, false),
+			),
+			ExactlyOneOf: []string{"protocol", "protocol_ports"},
+		},
+
+		"protocol_ports": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ProtocolWithPort,
+			},
+			ExactlyOneOf: []string{"protocol", "protocol_ports"},
+		},
+
+		"source": schema.GlobalSourceSchema(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r GlobalRuleStackPreRule) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GlobalRuleStackPreRule) ModelObject() interface{} {
+	return &GlobalRuleStackPreRuleModel{}
+}
+
+func (r GlobalRuleStackPreRule) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PreRules
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			model := GlobalRuleStackPreRuleModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			rulestackId, err := globalrulestack.ParseGlobalRulestackID(model.RuleStackID)
+			if err != nil {
+				return err
+			}
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			// As with Local Rules, the API uses Priority rather than Name for the ID
+			id := prerules.NewPreRuleID(rulestackId.GlobalRulestackName, strconv.FormatInt(model.Priority, 10))
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			destination, err := expandPreRuleDestination(model.Destination)
+			if err != nil {
+				return fmt.Errorf("expanding destination for %s, %+v", id, err)
+			}
+
+			source, err := expandPreRuleSource(model.Source)
+			if err != nil {
+				return fmt.Errorf("expanding source for %s: %+v", id, err)
+			}
+
+			props := prerules.RuleEntry{
+				Category:          expandPreRuleCategory(model.Category),
+				Destination:       destination,
+				EnableLogging:     boolAsStateEnumPreRule(model.LoggingEnabled),
+				NegateDestination: boolAsBooleanEnumPreRule(model.NegateDestination),
+				NegateSource:      boolAsBooleanEnumPreRule(model.NegateSource),
+				RuleName:          model.Name,
+				RuleState:         boolAsStateEnumPreRule(model.RuleEnabled),
+				Source:            source,
+				Tags:              expandTagsForPreRule(model.Tags),
+			}
+
+			if model.Action != "" {
+				props.ActionType = pointer.To(prerules.ActionEnum(model.Action))
+			}
+
+			if len(model.Applications) != 0 {
+				props.Applications = pointer.To(model.Applications)
+			}
+
+			if model.AuditComment != "" {
+				props.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if model.DecryptionRuleType != "" {
+				props.DecryptionRuleType = pointer.To(prerules.DecryptionRuleTypeEnum(model.DecryptionRuleType))
+			}
+
+			if model.Description != "" {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if model.InspectionCertificateID != "" {
+				certID, err := certificateobjectglobalrulestack.ParseCertificateID(model.InspectionCertificateID)
+				if err != nil {
+					return err
+				}
+				props.InboundInspectionCertificate = pointer.To(certID.CertificateName)
+			}
+
+			if model.Priority != 0 {
+				props.Priority = pointer.To(model.Priority)
+			}
+
+			if len(model.ProtocolPorts) != 0 {
+				props.ProtocolPortList = pointer.To(model.ProtocolPorts)
+			}
+
+			if model.Protocol != "" && !strings.EqualFold(model.Protocol, protocolApplicationDefault) && len(model.ProtocolPorts) == 0 {
+				props.Protocol = pointer.To(model.Protocol)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, id, prerules.PreRulesResource{Properties: props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err = rulestackClient.CommitThenPoll(ctx, *rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackPreRule) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PreRules
+
+			id, err := prerules.ParsePreRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GlobalRuleStackPreRuleModel
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state.RuleStackID = prerules.NewGlobalRulestackID(id.GlobalRulestackName).ID()
+			p, err := strconv.ParseInt(id.PreRuleName, 10, 0)
+			if err != nil {
+				return fmt.Errorf("parsing Rule Priority for %s: %+v", *id, err)
+			}
+			state.Priority = p
+			if model := existing.Model; model != nil {
+				props := model.Properties
+				state.Name = props.RuleName
+				state.Action = string(pointer.From(props.ActionType))
+				state.Applications = pointer.From(props.Applications)
+				state.AuditComment = pointer.From(props.AuditComment)
+				state.Category = flattenPreRuleCategory(props.Category)
+				state.DecryptionRuleType = string(pointer.From(props.DecryptionRuleType))
+				state.Description = pointer.From(props.Description)
+				state.Destination = flattenPreRuleDestination(props.Destination, *id)
+				state.LoggingEnabled = stateEnumAsBoolPreRule(props.EnableLogging)
+				if certName := pointer.From(props.InboundInspectionCertificate); certName != "" {
+					state.InspectionCertificateID = certificateobjectglobalrulestack.NewCertificateID(id.GlobalRulestackName, certName).ID()
+				}
+				state.NegateDestination = boolEnumAsBoolPreRule(props.NegateDestination)
+				state.NegateSource = boolEnumAsBoolPreRule(props.NegateSource)
+				state.Protocol = pointer.From(props.Protocol)
+				state.ProtocolPorts = pointer.From(props.ProtocolPortList)
+				state.RuleEnabled = stateEnumAsBoolPreRule(props.RuleState)
+				state.Source = flattenPreRuleSource(props.Source, *id)
+				state.Tags = flattenTagsFromPreRule(props.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GlobalRuleStackPreRule) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PreRules
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := prerules.ParsePreRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackPreRule) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PreRules
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			model := GlobalRuleStackPreRuleModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id, err := prerules.ParsePreRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			ruleEntry := *existing.Model
+
+			if metadata.ResourceData.HasChange("name") {
+				ruleEntry.Properties.RuleName = model.Name
+			}
+
+			if metadata.ResourceData.HasChange("action") {
+				ruleEntry.Properties.ActionType = pointer.To(prerules.ActionEnum(model.Action))
+			}
+
+			if metadata.ResourceData.HasChange("applications") {
+				ruleEntry.Properties.Applications = pointer.To(model.Applications)
+			}
+
+			if metadata.ResourceData.HasChange("audit_comment") {
+				ruleEntry.Properties.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if metadata.ResourceData.HasChange("category") {
+				ruleEntry.Properties.Category = expandPreRuleCategory(model.Category)
+			}
+
+			if metadata.ResourceData.HasChange("decryption_rule_type") {
+				ruleEntry.Properties.DecryptionRuleType = pointer.To(prerules.DecryptionRuleTypeEnum(model.DecryptionRuleType))
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				ruleEntry.Properties.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("destination") {
+				destination, err := expandPreRuleDestination(model.Destination)
+				if err != nil {
+					return fmt.Errorf("expanding destination for %s, %+v", id, err)
+				}
+				ruleEntry.Properties.Destination = destination
+			}
+
+			if metadata.ResourceData.HasChange("logging_enabled") {
+				ruleEntry.Properties.EnableLogging = boolAsStateEnumPreRule(model.LoggingEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("inspection_certificate_id") {
+				if model.InspectionCertificateID != "" {
+					certID, err := certificateobjectglobalrulestack.ParseCertificateID(model.InspectionCertificateID)
+					if err != nil {
+						return err
+					}
+					ruleEntry.Properties.InboundInspectionCertificate = pointer.To(certID.CertificateName)
+				} else {
+					ruleEntry.Properties.InboundInspectionCertificate = pointer.To("")
+				}
+			}
+
+			if metadata.ResourceData.HasChange("negate_destination") {
+				ruleEntry.Properties.NegateDestination = boolAsBooleanEnumPreRule(model.NegateDestination)
+			}
+
+			if metadata.ResourceData.HasChange("negate_source") {
+				ruleEntry.Properties.NegateSource = boolAsBooleanEnumPreRule(model.NegateSource)
+			}
+
+			if metadata.ResourceData.HasChange("protocol") {
+				if model.Protocol != "" && !strings.EqualFold(model.Protocol, protocolApplicationDefault) && len(model.ProtocolPorts) == 0 {
+					ruleEntry.Properties.Protocol = pointer.To(model.Protocol)
+				} else {
+					ruleEntry.Properties.Protocol = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("protocol_ports") {
+				if len(model.ProtocolPorts) != 0 {
+					ruleEntry.Properties.ProtocolPortList = pointer.To(model.ProtocolPorts)
+				} else {
+					ruleEntry.Properties.ProtocolPortList = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				ruleEntry.Properties.RuleState = boolAsStateEnumPreRule(model.RuleEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("source") {
+				source, err := expandPreRuleSource(model.Source)
+				if err != nil {
+					return fmt.Errorf("expanding source for %s: %+v", id, err)
+				}
+				ruleEntry.Properties.Source = source
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				ruleEntry.Properties.Tags = expandTagsForPreRule(model.Tags)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, *id, ruleEntry); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPreRuleSource(input []schema.GlobalSource) (*prerules.SourceAddr, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	s := input[0]
+
+	prefixLists, err := schema.ExpandGlobalRulestackPrefixListIDs(s.PrefixLists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &prerules.SourceAddr{
+		Cidrs:       pointer.To(s.CIDRS),
+		Countries:   pointer.To(s.Countries),
+		Feeds:       pointer.To(s.Feeds),
+		PrefixLists: pointer.To(prefixLists),
+	}, nil
+}
+
+func flattenPreRuleSource(input *prerules.SourceAddr, ruleId prerules.PreRuleId) []schema.GlobalSource {
+	if input == nil {
+		return []schema.GlobalSource{}
+	}
+
+	return []schema.GlobalSource{{
+		CIDRS:       pointer.From(input.Cidrs),
+		Countries:   pointer.From(input.Countries),
+		Feeds:       pointer.From(input.Feeds),
+		PrefixLists: schema.FlattenGlobalRulestackPrefixListIDs(ruleId.GlobalRulestackName, input.PrefixLists),
+	}}
+}
+
+func expandPreRuleDestination(input []schema.GlobalDestination) (*prerules.DestinationAddr, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	d := input[0]
+
+	prefixLists, err := schema.ExpandGlobalRulestackPrefixListIDs(d.PrefixLists)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdnLists, err := schema.ExpandGlobalRulestackFQDNListIDs(d.FQDNLists)
+	if err != nil {
+		return nil, err
+	}
+
+	return &prerules.DestinationAddr{
+		Cidrs:       pointer.To(d.CIDRS),
+		Countries:   pointer.To(d.Countries),
+		Feeds:       pointer.To(d.Feeds),
+		FqdnLists:   pointer.To(fqdnLists),
+		PrefixLists: pointer.To(prefixLists),
+	}, nil
+}
+
+func flattenPreRuleDestination(input *prerules.DestinationAddr, ruleId prerules.PreRuleId) []schema.GlobalDestination {
+	if input == nil {
+		return []schema.GlobalDestination{}
+	}
+
+	return []schema.GlobalDestination{{
+		CIDRS:       pointer.From(input.Cidrs),
+		Countries:   pointer.From(input.Countries),
+		Feeds:       pointer.From(input.Feeds),
+		FQDNLists:   schema.FlattenGlobalRulestackFQDNListIDs(ruleId.GlobalRulestackName, input.FqdnLists),
+		PrefixLists: schema.FlattenGlobalRulestackPrefixListIDs(ruleId.GlobalRulestackName, input.PrefixLists),
+	}}
+}
+
+func expandPreRuleCategory(input []schema.Category) *prerules.Category {
+	if len(input) == 0 {
+		return nil
+	}
+
+	c := input[0]
+
+	return &prerules.Category{
+		Feeds:     c.Feeds,
+		UrlCustom: c.CustomUrls,
+	}
+}
+
+func flattenPreRuleCategory(input *prerules.Category) []schema.Category {
+	if input == nil {
+		return []schema.Category{}
+	}
+
+	return []schema.Category{{
+		Feeds:      input.Feeds,
+		CustomUrls: input.UrlCustom,
+	}}
+}
+
+func boolAsStateEnumPreRule(input bool) *prerules.StateEnum {
+	var result prerules.StateEnum
+
+	if input {
+		result = prerules.StateEnumENABLED
+	} else {
+		result = prerules.StateEnumDISABLED
+	}
+
+	return pointer.To(result)
+}
+
+func stateEnumAsBoolPreRule(input *prerules.StateEnum) bool {
+	return pointer.From(input) == prerules.StateEnumENABLED
+}
+
+func boolAsBooleanEnumPreRule(input bool) *prerules.BooleanEnum {
+	var result prerules.BooleanEnum
+
+	if input {
+		result = prerules.BooleanEnumTRUE
+	} else {
+		result = prerules.BooleanEnumFALSE
+	}
+
+	return pointer.To(result)
+}
+
+func boolEnumAsBoolPreRule(input *prerules.BooleanEnum) bool {
+	return pointer.From(input) == prerules.BooleanEnumTRUE
+}
+
+func expandTagsForPreRule(input map[string]interface{}) *[]prerules.TagInfo {
+	result := make([]prerules.TagInfo, 0)
+	if len(input) == 0 {
+		return pointer.To(result)
+	}
+
+	for k, v := range input {
+		result = append(result, prerules.TagInfo{
+			Key:   k,
+			Value: v.(string),
+		})
+	}
+
+	return pointer.To(result)
+}
+
+func flattenTagsFromPreRule(input *[]prerules.TagInfo) map[string]interface{} {
+	if input == nil {
+		return map[string]interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	for _, v := range *input {
+		result[v.Key] = v.Value
+	}
+
+	return result
+}
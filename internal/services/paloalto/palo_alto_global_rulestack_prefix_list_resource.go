@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/globalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/prefixlistglobalrulestack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GlobalRuleStackPrefixList struct{}
+
+var _ sdk.ResourceWithUpdate = GlobalRuleStackPrefixList{}
+
+type GlobalRuleStackPrefixListModel struct {
+	Name         string   `tfschema:"name"`
+	RuleStackID  string   `tfschema:"rulestack_id"`
+	PrefixList   []string `tfschema:"prefix_list"`
+	AuditComment string   `tfschema:"audit_comment"`
+	Description  string   `tfschema:"description"`
+}
+
+func (r GlobalRuleStackPrefixList) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return prefixlistglobalrulestack.ValidatePrefixListID
+}
+
+func (r GlobalRuleStackPrefixList) ResourceType() string {
+	return "azurerm_palo_alto_global_rulestack_prefix_list"
+}
+
+func (r GlobalRuleStackPrefixList) ModelObject() interface{} {
+	return &GlobalRuleStackPrefixListModel{}
+}
+
+func (r GlobalRuleStackPrefixList) Arguments() map[string]*schema.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GlobalRuleStackPrefixListName,
+		},
+
+		"rulestack_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: prefixlistglobalrulestack.ValidateGlobalRulestackID,
+		},
+
+		"prefix_list": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"audit_comment": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (r GlobalRuleStackPrefixList) Attributes() map[string]*schema.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GlobalRuleStackPrefixList) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PrefixListGlobalRulestack
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+			model := GlobalRuleStackPrefixListModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			rulestackId, err := globalrulestack.ParseGlobalRulestackID(model.RuleStackID)
+			if err != nil {
+				return err
+			}
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			id := prefixlistglobalrulestack.NewPrefixListID(rulestackId.GlobalRulestackName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := prefixlistglobalrulestack.PrefixObject{
+				PrefixList: model.PrefixList,
+			}
+
+			if model.AuditComment != "" {
+				props.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if model.Description != "" {
+				props.Description = pointer.To(model.Description)
+			}
+
+			prefixList := prefixlistglobalrulestack.PrefixListGlobalRulestackResource{
+				Properties: props,
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, id, prefixList); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err = rulestackClient.CommitThenPoll(ctx, *rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackPrefixList) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PrefixListGlobalRulestack
+
+			id, err := prefixlistglobalrulestack.ParsePrefixListID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GlobalRuleStackPrefixListModel
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state.Name = id.PrefixListName
+			state.RuleStackID = prefixlistglobalrulestack.NewGlobalRulestackID(id.GlobalRulestackName).ID()
+			if model := existing.Model; model != nil {
+				props := model.Properties
+
+				state.PrefixList = props.PrefixList
+				state.AuditComment = pointer.From(props.AuditComment)
+				state.Description = pointer.From(props.Description)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GlobalRuleStackPrefixList) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PrefixListGlobalRulestack
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := prefixlistglobalrulestack.ParsePrefixListID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStackPrefixList) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.PrefixListGlobalRulestack
+			rulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := prefixlistglobalrulestack.ParsePrefixListID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			model := GlobalRuleStackPrefixListModel{}
+
+			if err = metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			rulestackId := globalrulestack.NewGlobalRulestackID(id.GlobalRulestackName)
+			locks.ByID(rulestackId.ID())
+			defer locks.UnlockByID(rulestackId.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s for update: %+v", *id, err)
+			}
+
+			prefixList := *existing.Model
+
+			if metadata.ResourceData.HasChange("prefix_list") {
+				prefixList.Properties.PrefixList = model.PrefixList
+			}
+
+			if metadata.ResourceData.HasChange("audit_comment") {
+				prefixList.Properties.AuditComment = pointer.To(model.AuditComment)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				prefixList.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, *id, prefixList); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err = rulestackClient.CommitThenPoll(ctx, rulestackId); err != nil {
+				return fmt.Errorf("committing Global Rulestack config for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/globalrulestack"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GlobalRuleStack struct{}
+
+var _ sdk.ResourceWithUpdate = GlobalRuleStack{}
+
+type GlobalRuleStackModel struct {
+	Name                 string `tfschema:"name"`
+	Location             string `tfschema:"location"`
+	AntiSpywareProfile   string `tfschema:"anti_spyware_profile"`
+	AntiVirusProfile     string `tfschema:"anti_virus_profile"`
+	DNSSubscription      string `tfschema:"dns_subscription"`
+	FileBlockingProfile  string `tfschema:"file_blocking_profile"`
+	URLFilteringProfile  string `tfschema:"url_filtering_profile"`
+	VulnerabilityProfile string `tfschema:"vulnerability_profile"`
+	Description          string `tfschema:"description"`
+}
+
+func (r GlobalRuleStack) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return globalrulestack.ValidateGlobalRulestackID
+}
+
+func (r GlobalRuleStack) ResourceType() string {
+	return "azurerm_palo_alto_global_rulestack"
+}
+
+func (r GlobalRuleStack) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GlobalRuleStackName,
+		},
+
+		"location": commonschema.Location(),
+
+		"vulnerability_profile": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				RuleStackSecurityServicesCustom,
+				RuleStackSecurityServicesBestPractice,
+			}, false),
+		},
+
+		"anti_spyware_profile": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				RuleStackSecurityServicesCustom,
+				RuleStackSecurityServicesBestPractice,
+			}, false),
+		},
+
+		"anti_virus_profile": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				RuleStackSecurityServicesCustom,
+				RuleStackSecurityServicesBestPractice,
+			}, false),
+		},
+
+		"url_filtering_profile": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				RuleStackSecurityServicesCustom,
+				RuleStackSecurityServicesBestPractice,
+			}, false),
+		},
+
+		"file_blocking_profile": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				RuleStackSecurityServicesCustom,
+				RuleStackSecurityServicesBestPractice,
+			}, false),
+		},
+
+		"dns_subscription": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				RuleStackSecurityServicesCustom,
+				RuleStackSecurityServicesBestPractice,
+			}, false),
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (r GlobalRuleStack) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r GlobalRuleStack) ModelObject() interface{} {
+	return &GlobalRuleStackModel{}
+}
+
+func (r GlobalRuleStack) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			model := GlobalRuleStackModel{}
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := globalrulestack.NewGlobalRulestackID(model.Name)
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			secServices := globalrulestack.SecurityServices{
+				AntiSpywareProfile:   pointer.To(RuleStackSecurityServicesNone),
+				AntiVirusProfile:     pointer.To(RuleStackSecurityServicesNone),
+				DnsSubscription:      pointer.To(RuleStackSecurityServicesNone),
+				FileBlockingProfile:  pointer.To(RuleStackSecurityServicesNone),
+				UrlFilteringProfile:  pointer.To(RuleStackSecurityServicesNone),
+				VulnerabilityProfile: pointer.To(RuleStackSecurityServicesNone),
+			}
+
+			if model.AntiSpywareProfile != "" {
+				secServices.AntiSpywareProfile = pointer.To(model.AntiSpywareProfile)
+			}
+			if model.AntiVirusProfile != "" {
+				secServices.AntiVirusProfile = pointer.To(model.AntiVirusProfile)
+			}
+			if model.DNSSubscription != "" {
+				secServices.DnsSubscription = pointer.To(model.DNSSubscription)
+			}
+			if model.FileBlockingProfile != "" {
+				secServices.FileBlockingProfile = pointer.To(model.FileBlockingProfile)
+			}
+			if model.URLFilteringProfile != "" {
+				secServices.UrlFilteringProfile = pointer.To(model.URLFilteringProfile)
+			}
+			if model.VulnerabilityProfile != "" {
+				secServices.VulnerabilityProfile = pointer.To(model.VulnerabilityProfile)
+			}
+
+			globalRuleStack := globalrulestack.GlobalRulestackResource{
+				Location: location.Normalize(model.Location),
+				Properties: globalrulestack.RulestackProperties{
+					DefaultMode:      pointer.To(globalrulestack.DefaultModeNONE),
+					Description:      pointer.To(model.Description),
+					Scope:            pointer.To(globalrulestack.ScopeTypeGLOBAL),
+					SecurityServices: pointer.To(secServices),
+				},
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, id, globalRuleStack); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStack) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := globalrulestack.ParseGlobalRulestackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GlobalRuleStackModel
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state.Name = id.GlobalRulestackName
+			if model := existing.Model; model != nil {
+				props := model.Properties
+
+				state.Description = pointer.From(props.Description)
+				state.Location = location.Normalize(model.Location)
+
+				if secServices := props.SecurityServices; secServices != nil {
+					if v := pointer.From(secServices.VulnerabilityProfile); v != RuleStackSecurityServicesNone {
+						state.VulnerabilityProfile = v
+					}
+					if v := pointer.From(secServices.AntiSpywareProfile); v != RuleStackSecurityServicesNone {
+						state.AntiSpywareProfile = v
+					}
+					if v := pointer.From(secServices.AntiVirusProfile); v != RuleStackSecurityServicesNone {
+						state.AntiVirusProfile = v
+					}
+					if v := pointer.From(secServices.FileBlockingProfile); v != RuleStackSecurityServicesNone {
+						state.FileBlockingProfile = v
+					}
+					if v := pointer.From(secServices.UrlFilteringProfile); v != RuleStackSecurityServicesNone {
+						state.URLFilteringProfile = v
+					}
+					if v := pointer.From(secServices.DnsSubscription); v != RuleStackSecurityServicesNone {
+						state.DNSSubscription = v
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GlobalRuleStack) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := globalrulestack.ParseGlobalRulestackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GlobalRuleStack) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			id, err := globalrulestack.ParseGlobalRulestackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			model := GlobalRuleStackModel{}
+
+			if err = metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			globalRuleStack := *existing.Model
+			update := globalRuleStack.Properties
+
+			if metadata.ResourceData.HasChange("description") {
+				update.Description = pointer.To(model.Description)
+			}
+
+			secServices := pointer.From(update.SecurityServices)
+
+			if metadata.ResourceData.HasChange("dns_subscription") {
+				secServices.DnsSubscription = pointer.To(securityServiceOrNone(model.DNSSubscription))
+			}
+
+			if metadata.ResourceData.HasChange("vulnerability_profile") {
+				secServices.VulnerabilityProfile = pointer.To(securityServiceOrNone(model.VulnerabilityProfile))
+			}
+
+			if metadata.ResourceData.HasChange("anti_spyware_profile") {
+				secServices.AntiSpywareProfile = pointer.To(securityServiceOrNone(model.AntiSpywareProfile))
+			}
+
+			if metadata.ResourceData.HasChange("anti_virus_profile") {
+				secServices.AntiVirusProfile = pointer.To(securityServiceOrNone(model.AntiVirusProfile))
+			}
+
+			if metadata.ResourceData.HasChange("url_filtering_profile") {
+				secServices.UrlFilteringProfile = pointer.To(securityServiceOrNone(model.URLFilteringProfile))
+			}
+
+			if metadata.ResourceData.HasChange("file_blocking_profile") {
+				secServices.FileBlockingProfile = pointer.To(securityServiceOrNone(model.FileBlockingProfile))
+			}
+
+			update.SecurityServices = pointer.To(secServices)
+
+			globalRuleStack.Properties = update
+
+			if err = client.CreateOrUpdateThenPoll(ctx, *id, globalRuleStack); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err = client.CommitThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("committing config for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func securityServiceOrNone(input string) string {
+	if input == "" {
+		return RuleStackSecurityServicesNone
+	}
+
+	return input
+}
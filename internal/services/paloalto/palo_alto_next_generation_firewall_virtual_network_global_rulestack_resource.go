@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/firewalls"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/globalrulestack"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NextGenerationFirewallVNetGlobalRulestackResource struct{}
+
+type NextGenerationFirewallVnetGlobalRulestackModel struct {
+	Name               string                      `tfschema:"name"`
+	ResourceGroupName  string                      `tfschema:"resource_group_name"`
+	Location           string                      `tfschema:"location"`
+	NetworkProfile     []schema.NetworkProfileVnet `tfschema:"network_profile"`
+	RuleStackId        string                      `tfschema:"rulestack_id"`
+	DNSSettings        []schema.DNSSettings        `tfschema:"dns_settings"`
+	FrontEnd           []schema.DestinationNAT     `tfschema:"destination_nat"`
+	MarketplaceOfferId string                      `tfschema:"marketplace_offer_id"`
+	PlanId             string                      `tfschema:"plan_id"`
+	Tags               map[string]interface{}      `tfschema:"tags"`
+}
+
+var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetGlobalRulestackResource{}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) ModelObject() interface{} {
+	return &NextGenerationFirewallVnetGlobalRulestackModel{}
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NextGenerationFirewallName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"rulestack_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: globalrulestack.ValidateGlobalRulestackID,
+		},
+
+		"network_profile": schema.VnetNetworkProfileSchema(),
+
+		// Optional
+		"dns_settings": schema.DNSSettingsSchema(),
+
+		"destination_nat": schema.DestinationNATSchema(),
+
+		"marketplace_offer_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "pan_swfw_cloud_ngfw",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"plan_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "panw-cngfw-payg",
+			ValidateFunc: validation.StringLenBetween(1, 50),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) ResourceType() string {
+	return "azurerm_palo_alto_next_generation_firewall_virtual_network_global_rulestack"
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.Firewalls
+			globalRulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+			var model NextGenerationFirewallVnetGlobalRulestackModel
+
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			id := firewalls.NewFirewallID(metadata.Client.Account.SubscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			ruleStackID, err := globalrulestack.ParseGlobalRulestackID(model.RuleStackId)
+			if err != nil {
+				return err
+			}
+
+			ruleStack, err := globalRulestackClient.Get(ctx, *ruleStackID)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", ruleStackID, id, err)
+			}
+			if ruleStack.Model == nil {
+				return fmt.Errorf("reading %s for %s: `model` was nil", ruleStackID, id)
+			}
+
+			firewall := firewalls.FirewallResource{
+				Location: location.Normalize(model.Location),
+				Properties: firewalls.FirewallDeploymentProperties{
+					AssociatedRulestack: &firewalls.RulestackDetails{
+						ResourceId: pointer.To(ruleStackID.ID()),
+						Location:   pointer.To(location.Normalize(ruleStack.Model.Location)),
+					},
+					DnsSettings: schema.ExpandDNSSettings(model.DNSSettings),
+					MarketplaceDetails: firewalls.MarketplaceDetails{
+						OfferId:     model.MarketplaceOfferId,
+						PublisherId: "paloaltonetworks",
+					},
+					NetworkProfile: schema.ExpandNetworkProfileVnet(model.NetworkProfile),
+					PlanData: firewalls.PlanData{
+						BillingCycle: firewalls.BillingCycleMONTHLY,
+						PlanId:       model.PlanId,
+					},
+					FrontEndSettings: schema.ExpandDestinationNAT(model.FrontEnd),
+				},
+				Tags: tags.Expand(model.Tags),
+			}
+
+			locks.ByID(ruleStackID.ID())
+			defer locks.UnlockByID(ruleStackID.ID())
+
+			if err = client.CreateOrUpdateThenPoll(ctx, id, firewall); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.Firewalls
+
+			id, err := firewalls.ParseFirewallID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state NextGenerationFirewallVnetGlobalRulestackModel
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state.Name = id.FirewallName
+
+			state.ResourceGroupName = id.ResourceGroupName
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				props := model.Properties
+
+				state.DNSSettings = schema.FlattenDNSSettings(props.DnsSettings)
+
+				state.NetworkProfile = schema.FlattenNetworkProfileVnet(props.NetworkProfile)
+
+				state.FrontEnd = schema.FlattenDestinationNAT(props.FrontEndSettings)
+
+				state.RuleStackId = pointer.From(props.AssociatedRulestack.ResourceId)
+
+				state.MarketplaceOfferId = props.MarketplaceDetails.OfferId
+
+				state.PlanId = props.PlanData.PlanId
+
+				state.Tags = tags.Flatten(existing.Model.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.Firewalls
+
+			id, err := firewalls.ParseFirewallID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err = client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return firewalls.ValidateFirewallID
+}
+
+func (r NextGenerationFirewallVNetGlobalRulestackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.Firewalls
+
+			id, err := firewalls.ParseFirewallID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			model := NextGenerationFirewallVnetGlobalRulestackModel{}
+
+			if err = metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			firewall := *existing.Model
+			props := firewall.Properties
+
+			if metadata.ResourceData.HasChange("rulestack_id") {
+				globalRulestackClient := metadata.Client.PaloAlto.PaloAltoClient_v2025_05_23.GlobalRulestack
+
+				ruleStackID, err := globalrulestack.ParseGlobalRulestackID(model.RuleStackId)
+				if err != nil {
+					return err
+				}
+
+				ruleStack, err := globalRulestackClient.Get(ctx, *ruleStackID)
+				if err != nil {
+					return fmt.Errorf("reading %s for %s: %+v", ruleStackID, id, err)
+				}
+				if ruleStack.Model == nil {
+					return fmt.Errorf("reading %s for %s: `model` was nil", ruleStackID, id)
+				}
+
+				props.AssociatedRulestack = &firewalls.RulestackDetails{
+					ResourceId: pointer.To(ruleStackID.ID()),
+					Location:   pointer.To(location.Normalize(ruleStack.Model.Location)),
+				}
+				locks.ByID(ruleStackID.ID())
+				defer locks.UnlockByID(ruleStackID.ID())
+			}
+
+			if metadata.ResourceData.HasChange("network_profile") {
+				props.NetworkProfile = schema.ExpandNetworkProfileVnet(model.NetworkProfile)
+			}
+
+			if metadata.ResourceData.HasChange("dns_settings") {
+				props.DnsSettings = schema.ExpandDNSSettings(model.DNSSettings)
+			}
+
+			if metadata.ResourceData.HasChange("destination_nat") {
+				props.FrontEndSettings = schema.ExpandDestinationNAT(model.FrontEnd)
+			}
+
+			if metadata.ResourceData.HasChange("plan_id") {
+				props.PlanData.PlanId = model.PlanId
+			}
+
+			firewall.Properties = props
+
+			if metadata.ResourceData.HasChange("tags") {
+				firewall.Tags = tags.Expand(model.Tags)
+			}
+
+			if err = client.CreateOrUpdateThenPoll(ctx, *id, firewall); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
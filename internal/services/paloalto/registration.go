@@ -29,6 +29,12 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		GlobalRuleStack{},
+		GlobalRuleStackCertificate{},
+		GlobalRulestackFQDNList{},
+		GlobalRuleStackPostRule{},
+		GlobalRuleStackPreRule{},
+		GlobalRuleStackPrefixList{},
 		LocalRuleStack{},
 		LocalRuleStackCertificate{},
 		LocalRulestackFQDNList{},
@@ -39,6 +45,7 @@ func (r Registration) Resources() []sdk.Resource {
 		NetworkVirtualApplianceResource{},
 		NextGenerationFirewallVHubLocalRuleStackResource{},
 		NextGenerationFirewallVHubPanoramaResource{},
+		NextGenerationFirewallVNetGlobalRulestackResource{},
 		NextGenerationFirewallVNetLocalRulestackResource{},
 		NextGenerationFirewallVNetPanoramaResource{},
 	}
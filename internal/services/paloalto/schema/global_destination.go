@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/fqdnlistglobalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/prefixlistglobalrulestack"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GlobalDestination struct {
+	CIDRS       []string `tfschema:"cidrs"`
+	Countries   []string `tfschema:"countries"`
+	Feeds       []string `tfschema:"feeds"`
+	FQDNLists   []string `tfschema:"global_rulestack_fqdn_list_ids"`
+	PrefixLists []string `tfschema:"global_rulestack_prefix_list_ids"`
+}
+
+func GlobalDestinationSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cidrs": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsCIDR,
+							validation.StringInSlice([]string{"any"}, false),
+						),
+					},
+					AtLeastOneOf: []string{
+						"destination.0.cidrs",
+						"destination.0.countries",
+						"destination.0.feeds",
+						"destination.0.global_rulestack_fqdn_list_ids",
+						"destination.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"countries": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validate.ISO3361CountryCode,
+					},
+					AtLeastOneOf: []string{
+						"destination.0.cidrs",
+						"destination.0.countries",
+						"destination.0.feeds",
+						"destination.0.global_rulestack_fqdn_list_ids",
+						"destination.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"feeds": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{
+						"destination.0.cidrs",
+						"destination.0.countries",
+						"destination.0.feeds",
+						"destination.0.global_rulestack_fqdn_list_ids",
+						"destination.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"global_rulestack_fqdn_list_ids": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: fqdnlistglobalrulestack.ValidateFqdnListID,
+					},
+					AtLeastOneOf: []string{
+						"destination.0.cidrs",
+						"destination.0.countries",
+						"destination.0.feeds",
+						"destination.0.global_rulestack_fqdn_list_ids",
+						"destination.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"global_rulestack_prefix_list_ids": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: prefixlistglobalrulestack.ValidatePrefixListID,
+					},
+					AtLeastOneOf: []string{
+						"destination.0.cidrs",
+						"destination.0.countries",
+						"destination.0.feeds",
+						"destination.0.global_rulestack_fqdn_list_ids",
+						"destination.0.global_rulestack_prefix_list_ids",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ExpandGlobalRulestackFQDNListIDs converts Global Rulestack FQDN List IDs into the names expected by the rules API.
+func ExpandGlobalRulestackFQDNListIDs(input []string) ([]string, error) {
+	result := make([]string, 0)
+	for _, v := range input {
+		id, err := fqdnlistglobalrulestack.ParseFqdnListID(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, id.FqdnListName)
+	}
+
+	return result, nil
+}
+
+// FlattenGlobalRulestackFQDNListIDs converts FQDN List names returned by the rules API into Global Rulestack FQDN List IDs.
+func FlattenGlobalRulestackFQDNListIDs(globalRulestackName string, input *[]string) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, fqdnlistglobalrulestack.NewFqdnListID(globalRulestackName, v).ID())
+	}
+
+	return result
+}
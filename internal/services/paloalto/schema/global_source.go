@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-05-23/prefixlistglobalrulestack"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/paloalto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type GlobalSource struct {
+	CIDRS       []string `tfschema:"cidrs"`
+	Countries   []string `tfschema:"countries"`
+	Feeds       []string `tfschema:"feeds"`
+	PrefixLists []string `tfschema:"global_rulestack_prefix_list_ids"`
+}
+
+func GlobalSourceSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cidrs": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsCIDR,
+							validation.StringInSlice([]string{"any"}, false),
+						),
+					},
+					AtLeastOneOf: []string{
+						"source.0.cidrs",
+						"source.0.countries",
+						"source.0.feeds",
+						"source.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"countries": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validate.ISO3361CountryCode,
+					},
+					AtLeastOneOf: []string{
+						"source.0.cidrs",
+						"source.0.countries",
+						"source.0.feeds",
+						"source.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"feeds": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{
+						"source.0.cidrs",
+						"source.0.countries",
+						"source.0.feeds",
+						"source.0.global_rulestack_prefix_list_ids",
+					},
+				},
+
+				"global_rulestack_prefix_list_ids": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: prefixlistglobalrulestack.ValidatePrefixListID,
+					},
+					AtLeastOneOf: []string{
+						"source.0.cidrs",
+						"source.0.countries",
+						"source.0.feeds",
+						"source.0.global_rulestack_prefix_list_ids",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ExpandGlobalRulestackPrefixListIDs converts Global Rulestack Prefix List IDs into the names expected by the rules API.
+func ExpandGlobalRulestackPrefixListIDs(input []string) ([]string, error) {
+	result := make([]string, 0)
+	for _, v := range input {
+		id, err := prefixlistglobalrulestack.ParsePrefixListID(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, id.PrefixListName)
+	}
+
+	return result, nil
+}
+
+// FlattenGlobalRulestackPrefixListIDs converts Prefix List names returned by the rules API into Global Rulestack Prefix List IDs.
+func FlattenGlobalRulestackPrefixListIDs(globalRulestackName string, input *[]string) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, prefixlistglobalrulestack.NewPrefixListID(globalRulestackName, v).ID())
+	}
+
+	return result
+}
@@ -29,6 +29,26 @@ func LocalRuleStackRuleName(input interface{}, k string) (warnings []string, err
 	return paloAltoNameValidation(input, k)
 }
 
+func GlobalRuleStackName(input interface{}, k string) (warnings []string, errors []error) {
+	return paloAltoNameValidation(input, k)
+}
+
+func GlobalRuleStackCertificateName(input interface{}, k string) (warnings []string, errors []error) {
+	return paloAltoNameValidation(input, k)
+}
+
+func GlobalRuleStackFQDNListName(input interface{}, k string) (warnings []string, errors []error) {
+	return paloAltoNameValidation(input, k)
+}
+
+func GlobalRuleStackPrefixListName(input interface{}, k string) (warnings []string, errors []error) {
+	return paloAltoNameValidation(input, k)
+}
+
+func GlobalRuleStackRuleName(input interface{}, k string) (warnings []string, errors []error) {
+	return paloAltoNameValidation(input, k)
+}
+
 func DestinationNATName(input interface{}, k string) (warnings []string, errors []error) {
 	return paloAltoNameValidation(input, k)
 }
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/accountmigrations"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &storageAccountMigrationPoller{}
+
+type storageAccountMigrationPoller struct {
+	client *accountmigrations.AccountMigrationsClient
+	id     commonids.StorageAccountId
+}
+
+// A redundancy conversion can take considerably longer than the Long Running Operation returned when starting it,
+// so the status of the migration itself is polled until it reaches a terminal state
+func NewStorageAccountMigrationPoller(client *accountmigrations.AccountMigrationsClient, id commonids.StorageAccountId) *storageAccountMigrationPoller {
+	return &storageAccountMigrationPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+func (p storageAccountMigrationPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.StorageAccountsGetCustomerInitiatedMigration(ctx, p.id)
+	if err != nil {
+		if resp.HttpResponse == nil {
+			return nil, pollers.PollingDroppedConnectionError{
+				Message: err.Error(),
+			}
+		}
+		// the migration may not be visible immediately after it's been submitted
+		if response.WasNotFound(resp.HttpResponse) {
+			return &pollers.PollResult{
+				HttpResponse: &client.Response{
+					Response: resp.HttpResponse,
+				},
+				PollInterval: 1 * time.Minute,
+				Status:       pollers.PollingStatusInProgress,
+			}, nil
+		}
+		return nil, fmt.Errorf("retrieving the account migration for %s: %+v", p.id, err)
+	}
+
+	status := ""
+	failedReason := ""
+	if model := resp.Model; model != nil {
+		status = string(pointer.From(model.Properties.MigrationStatus))
+		failedReason = pointer.From(model.Properties.MigrationFailedDetailedReason)
+		if failedReason == "" {
+			failedReason = pointer.From(model.Properties.MigrationFailedReason)
+		}
+	}
+
+	switch accountmigrations.MigrationStatus(status) {
+	case accountmigrations.MigrationStatusComplete:
+		return &pollers.PollResult{
+			HttpResponse: &client.Response{
+				Response: resp.HttpResponse,
+			},
+			PollInterval: 1 * time.Minute,
+			Status:       pollers.PollingStatusSucceeded,
+		}, nil
+
+	// the status is empty until the migration has been picked up
+	case "", accountmigrations.MigrationStatusSubmittedForConversion, accountmigrations.MigrationStatusInProgress:
+		return &pollers.PollResult{
+			HttpResponse: &client.Response{
+				Response: resp.HttpResponse,
+			},
+			PollInterval: 1 * time.Minute,
+			Status:       pollers.PollingStatusInProgress,
+		}, nil
+	}
+
+	return nil, pollers.PollingFailedError{
+		HttpResponse: &client.Response{
+			Response: resp.HttpResponse,
+		},
+		Message: fmt.Sprintf("unexpected migration status %q: %s", status, failedReason),
+	}
+}
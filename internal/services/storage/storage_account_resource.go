@@ -20,9 +20,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/accountmigrations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/blobservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -36,6 +38,7 @@ import (
 	managedHsmParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
 	managedHsmValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/custompollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -1176,6 +1179,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
 
+				// converting between the zonal and non-zonal variant of the same replication type (e.g. `LRS` to `ZRS`)
+				// is performed in-place using a customer initiated account migration
+				if storageAccountReplicationTypeIsZoneRedundancyConversion(old.(string), new.(string)) {
+					return false
+				}
+
 				switch strings.ToUpper(old.(string)) {
 				case "LRS", "GRS", "RAGRS":
 					if newAccRep == "GZRS" || newAccRep == "RAGZRS" || newAccRep == "ZRS" {
@@ -1716,6 +1725,26 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: `model.Sku` was nil", id)
 	}
 
+	if d.HasChange("account_replication_type") {
+		oldReplicationType, newReplicationType := d.GetChange("account_replication_type")
+		if storageAccountReplicationTypeIsZoneRedundancyConversion(oldReplicationType.(string), newReplicationType.(string)) {
+			migrationPayload := accountmigrations.StorageAccountMigration{
+				Properties: accountmigrations.StorageAccountMigrationProperties{
+					TargetSkuName: accountmigrations.SkuName(storageType),
+				},
+			}
+			if err := storageClient.AccountMigrations.StorageAccountsCustomerInitiatedMigrationThenPoll(ctx, *id, migrationPayload); err != nil {
+				return fmt.Errorf("migrating %s to %q: %+v", id, storageType, err)
+			}
+
+			pollerType := custompollers.NewStorageAccountMigrationPoller(storageClient.AccountMigrations, *id)
+			poller := pollers.NewPoller(pollerType, 1*time.Minute, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the migration of %s to %q: %+v", id, storageType, err)
+			}
+		}
+	}
+
 	props := storageaccounts.StorageAccountPropertiesCreateParameters{
 		AccessTier:                            existing.Model.Properties.AccessTier,
 		AllowBlobPublicAccess:                 existing.Model.Properties.AllowBlobPublicAccess,
@@ -3589,3 +3618,35 @@ func flattenEdgeZone(input *edgezones.Model) string {
 	}
 	return output
 }
+
+// storageAccountReplicationTypeIsZoneRedundancyConversion returns whether changing the replication type from `old` to `new`
+// only changes the zone redundancy of the Storage Account, which is supported in-place via a customer initiated migration.
+// Both the replication type (e.g. `LRS`) and the SKU name (e.g. `Premium_LRS`) are accepted.
+func storageAccountReplicationTypeIsZoneRedundancyConversion(old, new string) bool {
+	oldTier, oldReplicationType := storageAccountSkuNameParts(old)
+	newTier, newReplicationType := storageAccountSkuNameParts(new)
+	if !strings.EqualFold(oldTier, newTier) {
+		return false
+	}
+
+	zonalCounterparts := map[string]string{
+		"LRS":    "ZRS",
+		"ZRS":    "LRS",
+		"GRS":    "GZRS",
+		"GZRS":   "GRS",
+		"RAGRS":  "RAGZRS",
+		"RAGZRS": "RAGRS",
+	}
+
+	counterpart, ok := zonalCounterparts[strings.ToUpper(oldReplicationType)]
+	return ok && strings.EqualFold(counterpart, newReplicationType)
+}
+
+// storageAccountSkuNameParts splits a SKU name such as `Premium_LRS` into the tier and replication type, the tier is
+// empty when only a replication type is specified
+func storageAccountSkuNameParts(input string) (string, string) {
+	if tier, replicationType, ok := strings.Cut(input, "_"); ok {
+		return tier, replicationType
+	}
+	return "", input
+}
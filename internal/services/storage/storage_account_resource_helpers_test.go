@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"testing"
+)
+
+func TestStorageAccountReplicationTypeIsZoneRedundancyConversion(t *testing.T) {
+	testcases := []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Old:      "LRS",
+			New:      "ZRS",
+			Expected: true,
+		},
+		{
+			Old:      "ZRS",
+			New:      "LRS",
+			Expected: true,
+		},
+		{
+			Old:      "GRS",
+			New:      "GZRS",
+			Expected: true,
+		},
+		{
+			Old:      "RAGZRS",
+			New:      "RAGRS",
+			Expected: true,
+		},
+		{
+			Old:      "lrs",
+			New:      "Zrs",
+			Expected: true,
+		},
+		{
+			Old:      "RaGrS",
+			New:      "ragzrs",
+			Expected: true,
+		},
+		{
+			Old:      "Premium_LRS",
+			New:      "Premium_ZRS",
+			Expected: true,
+		},
+		{
+			Old:      "premium_zrs",
+			New:      "PREMIUM_lrs",
+			Expected: true,
+		},
+		{
+			Old:      "Standard_LRS",
+			New:      "Premium_ZRS",
+			Expected: false,
+		},
+		{
+			Old:      "Premium_LRS",
+			New:      "ZRS",
+			Expected: false,
+		},
+		{
+			Old:      "LRS",
+			New:      "GZRS",
+			Expected: false,
+		},
+		{
+			Old:      "GRS",
+			New:      "RAGRS",
+			Expected: false,
+		},
+		{
+			Old:      "LRS",
+			New:      "LRS",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		if actual := storageAccountReplicationTypeIsZoneRedundancyConversion(tc.Old, tc.New); actual != tc.Expected {
+			t.Errorf("expected %q to %q to return %t but got %t", tc.Old, tc.New, tc.Expected, actual)
+		}
+	}
+}
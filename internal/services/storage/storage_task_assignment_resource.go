@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = StorageTaskAssignmentResource{}
+
+type StorageTaskAssignmentResource struct{}
+
+type StorageTaskAssignmentResourceModel struct {
+	Name                  string                                  `tfschema:"name"`
+	StorageAccountId      string                                  `tfschema:"storage_account_id"`
+	StorageTaskId         string                                  `tfschema:"storage_task_id"`
+	Description           string                                  `tfschema:"description"`
+	Enabled               bool                                    `tfschema:"enabled"`
+	ExecutionTrigger      []StorageTaskAssignmentExecutionTrigger `tfschema:"execution_trigger"`
+	ReportPrefix          string                                  `tfschema:"report_prefix"`
+	TargetExcludePrefixes []string                                `tfschema:"target_exclude_prefixes"`
+	TargetPrefixes        []string                                `tfschema:"target_prefixes"`
+}
+
+type StorageTaskAssignmentExecutionTrigger struct {
+	Type         string `tfschema:"type"`
+	StartOn      string `tfschema:"start_on"`
+	StartFrom    string `tfschema:"start_from"`
+	EndBy        string `tfschema:"end_by"`
+	Interval     int64  `tfschema:"interval"`
+	IntervalUnit string `tfschema:"interval_unit"`
+}
+
+func (StorageTaskAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return storagetaskassignments.ValidateStorageTaskAssignmentID
+}
+
+func (StorageTaskAssignmentResource) ResourceType() string {
+	return "azurerm_storage_task_assignment"
+}
+
+func (StorageTaskAssignmentResource) ModelObject() interface{} {
+	return &StorageTaskAssignmentResourceModel{}
+}
+
+func (StorageTaskAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(3, 24),
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"storage_task_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"execution_trigger": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(storagetaskassignments.PossibleValuesForTriggerType(), false),
+					},
+
+					"start_on": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"start_from": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"end_by": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"interval": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"interval_unit": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(storagetaskassignments.PossibleValuesForIntervalUnit(), false),
+					},
+				},
+			},
+		},
+
+		"report_prefix": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"target_exclude_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"target_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (StorageTaskAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTaskAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			var config StorageTaskAssignmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageAccountId, err := commonids.ParseStorageAccountID(config.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := storagetaskassignments.NewStorageTaskAssignmentID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroupName, storageAccountId.StorageAccountName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := storagetaskassignments.StorageTaskAssignment{
+				Properties: storagetaskassignments.StorageTaskAssignmentProperties{
+					Description: config.Description,
+					Enabled:     config.Enabled,
+					ExecutionContext: storagetaskassignments.StorageTaskAssignmentExecutionContext{
+						Target:  expandStorageTaskAssignmentExecutionTarget(config),
+						Trigger: expandStorageTaskAssignmentExecutionTrigger(config.ExecutionTrigger),
+					},
+					Report: storagetaskassignments.StorageTaskAssignmentReport{
+						Prefix: config.ReportPrefix,
+					},
+					TaskId: config.StorageTaskId,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (StorageTaskAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageTaskAssignmentResourceModel{
+				Name:             id.StorageTaskAssignmentName,
+				StorageAccountId: commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.Description = props.Description
+				state.Enabled = props.Enabled
+				state.ReportPrefix = props.Report.Prefix
+				state.StorageTaskId = props.TaskId
+				state.ExecutionTrigger = flattenStorageTaskAssignmentExecutionTrigger(props.ExecutionContext.Trigger)
+
+				if target := props.ExecutionContext.Target; target != nil {
+					state.TargetExcludePrefixes = pointer.From(target.ExcludePrefix)
+					state.TargetPrefixes = pointer.From(target.Prefix)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (StorageTaskAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StorageTaskAssignmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := storagetaskassignments.StorageTaskAssignmentUpdateParameters{
+				Properties: &storagetaskassignments.StorageTaskAssignmentUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.Enabled = pointer.To(config.Enabled)
+			}
+
+			if metadata.ResourceData.HasChanges("execution_trigger", "target_exclude_prefixes", "target_prefixes") {
+				target := expandStorageTaskAssignmentExecutionTarget(config)
+				if metadata.ResourceData.HasChanges("target_exclude_prefixes", "target_prefixes") {
+					// the prefixes are sent explicitly, since the existing prefixes are kept when they're omitted
+					target = &storagetaskassignments.ExecutionTarget{
+						ExcludePrefix: pointer.To(append(make([]string, 0), config.TargetExcludePrefixes...)),
+						Prefix:        pointer.To(append(make([]string, 0), config.TargetPrefixes...)),
+					}
+				}
+
+				trigger := expandStorageTaskAssignmentExecutionTrigger(config.ExecutionTrigger)
+				payload.Properties.ExecutionContext = &storagetaskassignments.StorageTaskAssignmentUpdateExecutionContext{
+					Target: target,
+					Trigger: &storagetaskassignments.ExecutionTriggerUpdate{
+						Type: pointer.To(trigger.Type),
+						Parameters: &storagetaskassignments.TriggerParametersUpdate{
+							EndBy:        trigger.Parameters.EndBy,
+							Interval:     trigger.Parameters.Interval,
+							IntervalUnit: trigger.Parameters.IntervalUnit,
+							StartFrom:    trigger.Parameters.StartFrom,
+							StartOn:      trigger.Parameters.StartOn,
+						},
+					},
+				}
+			}
+
+			if metadata.ResourceData.HasChange("report_prefix") {
+				payload.Properties.Report = &storagetaskassignments.StorageTaskAssignmentUpdateReport{
+					Prefix: pointer.To(config.ReportPrefix),
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (StorageTaskAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.StorageTaskAssignments
+
+			id, err := storagetaskassignments.ParseStorageTaskAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageTaskAssignmentExecutionTarget(input StorageTaskAssignmentResourceModel) *storagetaskassignments.ExecutionTarget {
+	if len(input.TargetPrefixes) == 0 && len(input.TargetExcludePrefixes) == 0 {
+		return nil
+	}
+
+	return &storagetaskassignments.ExecutionTarget{
+		ExcludePrefix: pointer.To(input.TargetExcludePrefixes),
+		Prefix:        pointer.To(input.TargetPrefixes),
+	}
+}
+
+func expandStorageTaskAssignmentExecutionTrigger(input []StorageTaskAssignmentExecutionTrigger) storagetaskassignments.ExecutionTrigger {
+	if len(input) == 0 {
+		return storagetaskassignments.ExecutionTrigger{}
+	}
+
+	trigger := input[0]
+	result := storagetaskassignments.ExecutionTrigger{
+		Type: storagetaskassignments.TriggerType(trigger.Type),
+	}
+
+	if trigger.StartOn != "" {
+		result.Parameters.StartOn = pointer.To(trigger.StartOn)
+	}
+
+	if trigger.StartFrom != "" {
+		result.Parameters.StartFrom = pointer.To(trigger.StartFrom)
+	}
+
+	if trigger.EndBy != "" {
+		result.Parameters.EndBy = pointer.To(trigger.EndBy)
+	}
+
+	if trigger.Interval != 0 {
+		result.Parameters.Interval = pointer.To(trigger.Interval)
+	}
+
+	if trigger.IntervalUnit != "" {
+		result.Parameters.IntervalUnit = pointer.To(storagetaskassignments.IntervalUnit(trigger.IntervalUnit))
+	}
+
+	return result
+}
+
+func flattenStorageTaskAssignmentExecutionTrigger(input storagetaskassignments.ExecutionTrigger) []StorageTaskAssignmentExecutionTrigger {
+	return []StorageTaskAssignmentExecutionTrigger{
+		{
+			Type:         string(input.Type),
+			StartOn:      pointer.From(input.Parameters.StartOn),
+			StartFrom:    pointer.From(input.Parameters.StartFrom),
+			EndBy:        pointer.From(input.Parameters.EndBy),
+			Interval:     pointer.From(input.Parameters.Interval),
+			IntervalUnit: string(pointer.From(input.Parameters.IntervalUnit)),
+		},
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storagetaskassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageTaskAssignmentResource struct {
+	storageTaskId          string
+	storageTaskPrincipalId string
+}
+
+func newStorageTaskAssignmentResource(t *testing.T) StorageTaskAssignmentResource {
+	r := StorageTaskAssignmentResource{
+		storageTaskId:          os.Getenv("ARM_TEST_STORAGE_TASK_ID"),
+		storageTaskPrincipalId: os.Getenv("ARM_TEST_STORAGE_TASK_PRINCIPAL_ID"),
+	}
+
+	// Storage Tasks (`Microsoft.StorageActions/storageTasks`) can't currently be provisioned by the provider
+	if r.storageTaskId == "" || r.storageTaskPrincipalId == "" {
+		t.Skip("skipping since `ARM_TEST_STORAGE_TASK_ID` and `ARM_TEST_STORAGE_TASK_PRINCIPAL_ID` have not been specified")
+	}
+
+	return r
+}
+
+func TestAccStorageTaskAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := newStorageTaskAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTaskAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := newStorageTaskAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTaskAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_task_assignment", "test")
+	r := newStorageTaskAssignmentResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeWithoutTargetPrefixes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_prefixes.#").HasValue("0"),
+				check.That(data.ResourceName).Key("target_exclude_prefixes.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTaskAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := storagetaskassignments.ParseStorageTaskAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Storage.ResourceManager.StorageTaskAssignments.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StorageTaskAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name               = "reports"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = "%[4]s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, r.storageTaskPrincipalId)
+}
+
+func (r StorageTaskAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "acctest%s"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = "%s"
+  description        = "acctest task assignment"
+  report_prefix      = azurerm_storage_container.test.name
+
+  execution_trigger {
+    type     = "RunOnce"
+    start_on = "%s"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, r.storageTaskId, time.Now().UTC().Add(time.Hour).Format(time.RFC3339))
+}
+
+func (r StorageTaskAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "import" {
+  name               = azurerm_storage_task_assignment.test.name
+  storage_account_id = azurerm_storage_task_assignment.test.storage_account_id
+  storage_task_id    = azurerm_storage_task_assignment.test.storage_task_id
+  description        = azurerm_storage_task_assignment.test.description
+  report_prefix      = azurerm_storage_task_assignment.test.report_prefix
+
+  execution_trigger {
+    type     = azurerm_storage_task_assignment.test.execution_trigger.0.type
+    start_on = azurerm_storage_task_assignment.test.execution_trigger.0.start_on
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageTaskAssignmentResource) complete(data acceptance.TestData) string {
+	startFrom := time.Now().UTC().Add(time.Hour)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name                    = "acctest%s"
+  storage_account_id      = azurerm_storage_account.test.id
+  storage_task_id         = "%s"
+  description             = "acctest task assignment updated"
+  enabled                 = false
+  report_prefix           = "${azurerm_storage_container.test.name}/updated"
+  target_prefixes         = ["logs/"]
+  target_exclude_prefixes = ["logs/keep/"]
+
+  execution_trigger {
+    type          = "OnSchedule"
+    start_from    = "%s"
+    end_by        = "%s"
+    interval      = 7
+    interval_unit = "Days"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, r.storageTaskId, startFrom.Format(time.RFC3339), startFrom.AddDate(0, 1, 0).Format(time.RFC3339))
+}
+
+func (r StorageTaskAssignmentResource) completeWithoutTargetPrefixes(data acceptance.TestData) string {
+	startFrom := time.Now().UTC().Add(time.Hour)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_task_assignment" "test" {
+  name               = "acctest%s"
+  storage_account_id = azurerm_storage_account.test.id
+  storage_task_id    = "%s"
+  description        = "acctest task assignment updated"
+  enabled            = false
+  report_prefix      = "${azurerm_storage_container.test.name}/updated"
+
+  execution_trigger {
+    type          = "OnSchedule"
+    start_from    = "%s"
+    end_by        = "%s"
+    interval      = 7
+    interval_unit = "Days"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomString, r.storageTaskId, startFrom.Format(time.RFC3339), startFrom.AddDate(0, 1, 0).Format(time.RFC3339))
+}
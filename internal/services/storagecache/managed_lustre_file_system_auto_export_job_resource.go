@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/autoexportjob"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/autoexportjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagedLustreFileSystemAutoExportJobModel struct {
+	Name                      string            `tfschema:"name"`
+	ManagedLustreFileSystemId string            `tfschema:"managed_lustre_file_system_id"`
+	Location                  string            `tfschema:"location"`
+	AutoExportPrefixes        []string          `tfschema:"auto_export_prefixes"`
+	Enabled                   bool              `tfschema:"enabled"`
+	Tags                      map[string]string `tfschema:"tags"`
+
+	State                                    string `tfschema:"state"`
+	StatusCode                               string `tfschema:"status_code"`
+	StatusMessage                            string `tfschema:"status_message"`
+	ExportIterationCount                     int64  `tfschema:"export_iteration_count"`
+	TotalFilesExported                       int64  `tfschema:"total_files_exported"`
+	TotalFilesFailed                         int64  `tfschema:"total_files_failed"`
+	TotalMiBExported                         int64  `tfschema:"total_mib_exported"`
+	LastStartedTimeUTC                       string `tfschema:"last_started_time_utc"`
+	LastCompletionTimeUTC                    string `tfschema:"last_completion_time_utc"`
+	LastSuccessfulIterationCompletionTimeUTC string `tfschema:"last_successful_iteration_completion_time_utc"`
+}
+
+type ManagedLustreFileSystemAutoExportJobResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagedLustreFileSystemAutoExportJobResource{}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) ResourceType() string {
+	return "azurerm_managed_lustre_file_system_auto_export_job"
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) ModelObject() interface{} {
+	return &ManagedLustreFileSystemAutoExportJobModel{}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return autoexportjobs.ValidateAutoExportJobID
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagedLustreFileSystemName,
+		},
+
+		"managed_lustre_file_system_id": commonschema.ResourceIDReferenceRequiredForceNew(&autoexportjob.AmlFilesystemId{}),
+
+		"location": commonschema.Location(),
+
+		"auto_export_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ImportPrefix,
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status_code": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"export_iteration_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_files_exported": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_files_failed": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_mib_exported": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"last_started_time_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_completion_time_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_successful_iteration_completion_time_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagedLustreFileSystemAutoExportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.StorageCache.AutoExportJob
+			getClient := metadata.Client.StorageCache.AutoExportJobs
+
+			amlFilesystemId, err := autoexportjob.ParseAmlFilesystemID(model.ManagedLustreFileSystemId)
+			if err != nil {
+				return err
+			}
+
+			id := autoexportjob.NewAutoExportJobID(amlFilesystemId.SubscriptionId, amlFilesystemId.ResourceGroupName, amlFilesystemId.AmlFilesystemName, model.Name)
+
+			existing, err := getClient.Get(ctx, autoexportjobs.NewAutoExportJobID(id.SubscriptionId, id.ResourceGroupName, id.AmlFilesystemName, id.AutoExportJobName))
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			adminStatus := autoexportjob.AutoExportJobAdminStatusEnable
+			if !model.Enabled {
+				adminStatus = autoexportjob.AutoExportJobAdminStatusDisable
+			}
+
+			properties := autoexportjob.AutoExportJob{
+				Location: location.Normalize(model.Location),
+				Properties: &autoexportjob.AutoExportJobProperties{
+					AdminStatus:        pointer.To(adminStatus),
+					AutoExportPrefixes: pointer.To(model.AutoExportPrefixes),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.AutoExportJob
+
+			id, err := autoexportjob.ParseAutoExportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedLustreFileSystemAutoExportJobModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := autoexportjob.AutoExportJobUpdate{}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				adminStatus := autoexportjob.AutoExportJobAdminStatusEnable
+				if !model.Enabled {
+					adminStatus = autoexportjob.AutoExportJobAdminStatusDisable
+				}
+
+				properties.Properties = &autoexportjob.AutoExportJobUpdateProperties{
+					AdminStatus: pointer.To(adminStatus),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.AutoExportJobs
+
+			id, err := autoexportjobs.ParseAutoExportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedLustreFileSystemAutoExportJobModel{
+				Name:                      id.AutoExportJobName,
+				ManagedLustreFileSystemId: autoexportjob.NewAmlFilesystemID(id.SubscriptionId, id.ResourceGroupName, id.AmlFilesystemName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if properties := model.Properties; properties != nil {
+					state.AutoExportPrefixes = pointer.From(properties.AutoExportPrefixes)
+					state.Enabled = pointer.From(properties.AdminStatus) == autoexportjobs.AutoExportJobAdminStatusEnable
+
+					if status := properties.Status; status != nil {
+						state.State = string(pointer.From(status.State))
+						state.StatusCode = pointer.From(status.StatusCode)
+						state.StatusMessage = pointer.From(status.StatusMessage)
+						state.ExportIterationCount = pointer.From(status.ExportIterationCount)
+						state.TotalFilesExported = pointer.From(status.TotalFilesExported)
+						state.TotalFilesFailed = pointer.From(status.TotalFilesFailed)
+						state.TotalMiBExported = pointer.From(status.TotalMiBExported)
+						state.LastStartedTimeUTC = pointer.From(status.LastStartedTimeUTC)
+						state.LastCompletionTimeUTC = pointer.From(status.LastCompletionTimeUTC)
+						state.LastSuccessfulIterationCompletionTimeUTC = pointer.From(status.LastSuccessfulIterationCompletionTimeUTC)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.AutoExportJobs
+
+			id, err := autoexportjobs.ParseAutoExportJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/autoexportjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagedLustreFileSystemAutoExportJobResource struct{}
+
+func TestAccManagedLustreFileSystemAutoExportJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedLustreFileSystemAutoExportJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagedLustreFileSystemAutoExportJob_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedLustreFileSystemAutoExportJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_auto_export_job", "test")
+	r := ManagedLustreFileSystemAutoExportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := autoexportjobs.ParseAutoExportJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageCache.AutoExportJobs.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "test" {
+  name                          = "acctest-aej-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  location                      = azurerm_managed_lustre_file_system.test.location
+  auto_export_prefixes          = ["/"]
+}
+`, ManagedLustreFileSystemResource{}.templateWithHsmSetting(data), data.RandomInteger)
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "import" {
+  name                          = azurerm_managed_lustre_file_system_auto_export_job.test.name
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system_auto_export_job.test.managed_lustre_file_system_id
+  location                      = azurerm_managed_lustre_file_system_auto_export_job.test.location
+  auto_export_prefixes          = azurerm_managed_lustre_file_system_auto_export_job.test.auto_export_prefixes
+}
+`, r.basic(data))
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "test" {
+  name                          = "acctest-aej-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  location                      = azurerm_managed_lustre_file_system.test.location
+  auto_export_prefixes          = ["/data"]
+  enabled                       = true
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, ManagedLustreFileSystemResource{}.templateWithHsmSetting(data), data.RandomInteger)
+}
+
+func (r ManagedLustreFileSystemAutoExportJobResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_auto_export_job" "test" {
+  name                          = "acctest-aej-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  location                      = azurerm_managed_lustre_file_system.test.location
+  auto_export_prefixes          = ["/data"]
+  enabled                       = false
+
+  tags = {
+    Env  = "Test2"
+    Team = "HPC"
+  }
+}
+`, ManagedLustreFileSystemResource{}.templateWithHsmSetting(data), data.RandomInteger)
+}
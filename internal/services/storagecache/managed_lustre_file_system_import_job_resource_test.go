@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/importjobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagedLustreFileSystemImportJobResource struct{}
+
+func TestAccManagedLustreFileSystemImportJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_import_job", "test")
+	r := ManagedLustreFileSystemImportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedLustreFileSystemImportJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_import_job", "test")
+	r := ManagedLustreFileSystemImportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagedLustreFileSystemImportJob_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_import_job", "test")
+	r := ManagedLustreFileSystemImportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagedLustreFileSystemImportJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_lustre_file_system_import_job", "test")
+	r := ManagedLustreFileSystemImportJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagedLustreFileSystemImportJobResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := importjobs.ParseImportJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageCache.ImportJobs.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagedLustreFileSystemImportJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_import_job" "test" {
+  name                          = "acctest-ij-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  location                      = azurerm_managed_lustre_file_system.test.location
+}
+`, ManagedLustreFileSystemResource{}.templateWithHsmSetting(data), data.RandomInteger)
+}
+
+func (r ManagedLustreFileSystemImportJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_import_job" "import" {
+  name                          = azurerm_managed_lustre_file_system_import_job.test.name
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system_import_job.test.managed_lustre_file_system_id
+  location                      = azurerm_managed_lustre_file_system_import_job.test.location
+}
+`, r.basic(data))
+}
+
+func (r ManagedLustreFileSystemImportJobResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_import_job" "test" {
+  name                          = "acctest-ij-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  location                      = azurerm_managed_lustre_file_system.test.location
+  conflict_resolution_mode      = "OverwriteIfDirty"
+  import_prefixes               = ["/data", "/models"]
+  maximum_errors                = 10
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, ManagedLustreFileSystemResource{}.templateWithHsmSetting(data), data.RandomInteger)
+}
+
+func (r ManagedLustreFileSystemImportJobResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_lustre_file_system_import_job" "test" {
+  name                          = "acctest-ij-%d"
+  managed_lustre_file_system_id = azurerm_managed_lustre_file_system.test.id
+  location                      = azurerm_managed_lustre_file_system.test.location
+  conflict_resolution_mode      = "OverwriteIfDirty"
+  import_prefixes               = ["/data", "/models"]
+  maximum_errors                = 10
+
+  tags = {
+    Env  = "Test2"
+    Team = "HPC"
+  }
+}
+`, ManagedLustreFileSystemResource{}.templateWithHsmSetting(data), data.RandomInteger)
+}